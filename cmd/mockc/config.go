@@ -3,15 +3,58 @@ package main
 import (
 	"errors"
 	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/KimMachineGun/mockc/internal/mockc"
 )
 
 type Config struct {
-	destination     string
-	name            string
-	withConstructor bool
-	fieldNamePrefix string
-	fieldNameSuffix string
-	args            []string
+	config           string
+	destination      string
+	pkg              string
+	name             string
+	withConstructor  bool
+	fieldNamePrefix  string
+	fieldNameSuffix  string
+	paramNames       bool
+	withExpectations bool
+	withTestingT     bool
+	strict           bool
+	withCallOrder    bool
+	spy              bool
+	withMatchers     bool
+	withAccessors    bool
+	hideFields       bool
+	withWaiters      bool
+	withReset        bool
+	withFailures     bool
+	withLatency      bool
+	withTranscript   bool
+	withRecordReplay bool
+	check            bool
+	dryRun           bool
+	verbose          bool
+	jobs             int
+	cache            string
+	args             []string
+	// mockFlags are the names of the flag mode flags set in the command line.
+	mockFlags []string
+}
+
+func (c Config) IsConfigMode() bool {
+	return c.config != ""
+}
+
+func (c Config) ValidateConfig() error {
+	if len(c.mockFlags) > 0 {
+		return fmt.Errorf("config flag cannot be used with the command line flags of the mock: -%s", strings.Join(c.mockFlags, ", -"))
+	}
+	if len(c.args) > 0 {
+		return errors.New("config flag cannot be used with the patterns")
+	}
+
+	return nil
 }
 
 func (c Config) IsGeneratorMode() bool {
@@ -36,18 +79,82 @@ func (c Config) ValidateFlags() error {
 	return nil
 }
 
+func (c Config) MockFlags() mockc.MockFlags {
+	return mockc.MockFlags{
+		Destination:      c.destination,
+		Package:          c.pkg,
+		Name:             c.name,
+		WithConstructor:  c.withConstructor,
+		FieldNamePrefix:  c.fieldNamePrefix,
+		FieldNameSuffix:  c.fieldNameSuffix,
+		ParamNames:       c.paramNames,
+		WithExpectations: c.withExpectations,
+		WithTestingT:     c.withTestingT,
+		Strict:           c.strict,
+		WithCallOrder:    c.withCallOrder,
+		Spy:              c.spy,
+		WithMatchers:     c.withMatchers,
+		WithAccessors:    c.withAccessors,
+		HideFields:       c.hideFields,
+		WithWaiters:      c.withWaiters,
+		WithReset:        c.withReset,
+		WithFailures:     c.withFailures,
+		WithLatency:      c.withLatency,
+		WithTranscript:   c.withTranscript,
+		WithRecordReplay: c.withRecordReplay,
+		Interfaces:       c.args,
+	}
+}
+
+func (c Config) Options() mockc.Options {
+	return mockc.Options{
+		Check:   c.check,
+		DryRun:  c.dryRun,
+		Verbose: c.verbose,
+		Jobs:    c.jobs,
+		Cache:   c.cache,
+	}
+}
+
 func LoadConfig() Config {
 	var c Config
 
-	flag.StringVar(&c.destination, "destination", "", "flag mode: mock file destination")
+	flag.StringVar(&c.config, "config", "", "path of the yaml or json config file that describes the mocks")
+	flag.BoolVar(&c.check, "check", false, "check whether the generated files are up to date without writing them")
+	flag.StringVar(&c.cache, "cache", "", "path of the file that caches the method set hashes of the generated mocks to skip rendering the unchanged ones")
+	flag.IntVar(&c.jobs, "j", 0, "maximum number of the packages parsed and rendered concurrently (default GOMAXPROCS)")
+	flag.BoolVar(&c.verbose, "v", false, "log the time taken to load the packages and to generate the mocks")
+	flag.BoolVar(&c.dryRun, "dry-run", false, "print the generated files to stdout without writing them")
+	flag.StringVar(&c.destination, "destination", "", "flag mode: mock file destination, or - for stdout")
+	flag.StringVar(&c.pkg, "package", "", "flag mode: package name of the mock, required if the destination is stdout")
 	flag.StringVar(&c.name, "name", "", "flag mode: name of the mock")
 	flag.BoolVar(&c.withConstructor, "withConstructor", false, "flag mode: generate constructor")
 	flag.StringVar(&c.fieldNamePrefix, "fieldNamePrefix", "_", "flag mode: prefix of the mock's field names")
 	flag.StringVar(&c.fieldNameSuffix, "fieldNameSuffix", "", "flag mode: suffix of the mock's field names")
+	flag.BoolVar(&c.withExpectations, "withExpectations", false, "flag mode: generate expectation api")
+	flag.BoolVar(&c.withTestingT, "withTestingT", false, "flag mode: generate constructor that takes testing.TB and verifies the mock on cleanup")
+	flag.BoolVar(&c.strict, "strict", false, "flag mode: make the mock's methods fail on unconfigured calls")
+	flag.BoolVar(&c.withCallOrder, "withCallOrder", false, "flag mode: record the global sequence number of the method calls")
+	flag.BoolVar(&c.spy, "spy", false, "flag mode: generate spy that forwards the calls to the delegate unless the body is set")
+	flag.BoolVar(&c.withMatchers, "withMatchers", false, "flag mode: generate methods that find the calls with the argument matchers")
+	flag.BoolVar(&c.withAccessors, "withAccessors", false, "flag mode: generate methods that access the recorded state with the locks held")
+	flag.BoolVar(&c.hideFields, "hideFields", false, "flag mode: make the recorded state unexported, and generate its accessors")
+	flag.BoolVar(&c.withWaiters, "withWaiters", false, "flag mode: generate methods that block until the methods are called")
+	flag.BoolVar(&c.withReset, "withReset", false, "flag mode: generate methods that clear the recorded calls")
+	flag.BoolVar(&c.withFailures, "withFailures", false, "flag mode: generate methods that inject the panics and the errors")
+	flag.BoolVar(&c.withLatency, "withLatency", false, "flag mode: generate delay and context cancellation settings of the methods taking context")
+	flag.BoolVar(&c.withTranscript, "withTranscript", false, "flag mode: generate the transcript of all the recorded calls (implies -withCallOrder)")
+	flag.BoolVar(&c.withRecordReplay, "withRecordReplay", false, "flag mode: generate the spy that records the calls into a fixture and replays them (implies -spy)")
+	flag.BoolVar(&c.paramNames, "paramNames", false, "flag mode: name the params and results after the interface's declared names")
 
 	flag.Parse()
 
 	c.args = flag.Args()
+	flag.Visit(func(f *flag.Flag) {
+		if strings.HasPrefix(f.Usage, "flag mode:") {
+			c.mockFlags = append(c.mockFlags, f.Name)
+		}
+	})
 
 	return c
 }
@@ -17,12 +17,17 @@ func main() {
 	}
 
 	c := LoadConfig()
-	if c.IsGeneratorMode() {
-		err = mockc.Generate(context.Background(), wd, c.args)
+	if c.IsConfigMode() {
+		err = c.ValidateConfig()
+		if err == nil {
+			err = mockc.GenerateWithConfig(context.Background(), wd, c.config, c.Options())
+		}
+	} else if c.IsGeneratorMode() {
+		err = mockc.Generate(context.Background(), wd, c.args, c.Options())
 	} else {
 		err = c.ValidateFlags()
 		if err == nil {
-			err = mockc.GenerateWithFlags(context.Background(), wd, c.destination, c.name, c.withConstructor, c.fieldNamePrefix, c.fieldNameSuffix, c.args)
+			err = mockc.GenerateWithFlags(context.Background(), wd, c.MockFlags(), c.Options())
 		}
 	}
 	if err != nil {
@@ -65,3 +65,34 @@ func TestHasKey_WithBodyInjection(t *testing.T) {
 		t.Errorf("Cache.Get should be called once: actual(%d)", m._Get.CallCount)
 	}
 }
+
+func TestHasKey_WithResultsSeq(t *testing.T) {
+	m := &MockcCache{}
+
+	// set return values for each call
+	m._Get.ResultsSeq = append(m._Get.ResultsSeq,
+		struct {
+			R0 interface{}
+			R1 error
+		}{R1: errors.New("error")},
+		struct {
+			R0 interface{}
+			R1 error
+		}{R0: struct{}{}},
+	)
+
+	// execute
+	key := "test_key"
+	_, err := HasKey(m, key)
+	if err == nil {
+		t.Error("err should not be nil on the first call")
+	}
+	result, err := HasKey(m, key)
+	if !result || err != nil {
+		t.Errorf("result should be true on the second call: actual(%t, %v)", result, err)
+	}
+	result, err = HasKey(m, key)
+	if result || err != nil {
+		t.Errorf("results should fall back to the Results after the sequence is consumed: actual(%t, %v)", result, err)
+	}
+}
@@ -2,6 +2,7 @@
 // repo: https://github.com/KimMachineGun/mockc
 
 //go:generate mockc
+//go:build !mockc
 // +build !mockc
 
 package basic
@@ -36,6 +37,10 @@ type MockcCache struct {
 		Results struct {
 			R0 error
 		}
+		// if it is not empty, its first element will be consumed instead of the results.
+		ResultsSeq []struct {
+			R0 error
+		}
 		// if it is not nil, it'll be called in the middle of the method.
 		Body func(string) error
 	}
@@ -64,6 +69,11 @@ type MockcCache struct {
 			R0 interface{}
 			R1 error
 		}
+		// if it is not empty, its first element will be consumed instead of the results.
+		ResultsSeq []struct {
+			R0 interface{}
+			R1 error
+		}
 		// if it is not nil, it'll be called in the middle of the method.
 		Body func(string) (interface{}, error)
 	}
@@ -92,6 +102,10 @@ type MockcCache struct {
 		Results struct {
 			R0 error
 		}
+		// if it is not empty, its first element will be consumed instead of the results.
+		ResultsSeq []struct {
+			R0 error
+		}
 		// if it is not nil, it'll be called in the middle of the method.
 		Body func(string, interface{}) error
 	}
@@ -105,9 +119,17 @@ func (recv *MockcCache) Del(p0 string) error {
 	recv._Del.CallCount++
 	// params
 	recv._Del.Params.P0 = p0
+	// default results
+	results := recv._Del.Results
+	// results sequence
+	if recv._Del.Body == nil && len(recv._Del.ResultsSeq) > 0 {
+		results = recv._Del.ResultsSeq[0]
+		recv._Del.ResultsSeq = recv._Del.ResultsSeq[1:]
+	}
 	// body
 	if recv._Del.Body != nil {
-		recv._Del.Results.R0 = recv._Del.Body(p0)
+		results.R0 = recv._Del.Body(p0)
+		recv._Del.Results = results
 	}
 	// call history
 	recv._Del.History = append(recv._Del.History, struct {
@@ -119,10 +141,10 @@ func (recv *MockcCache) Del(p0 string) error {
 		}
 	}{
 		Params:  recv._Del.Params,
-		Results: recv._Del.Results,
+		Results: results,
 	})
 	// results
-	return recv._Del.Results.R0
+	return results.R0
 }
 
 func (recv *MockcCache) Get(p0 string) (interface{}, error) {
@@ -133,9 +155,17 @@ func (recv *MockcCache) Get(p0 string) (interface{}, error) {
 	recv._Get.CallCount++
 	// params
 	recv._Get.Params.P0 = p0
+	// default results
+	results := recv._Get.Results
+	// results sequence
+	if recv._Get.Body == nil && len(recv._Get.ResultsSeq) > 0 {
+		results = recv._Get.ResultsSeq[0]
+		recv._Get.ResultsSeq = recv._Get.ResultsSeq[1:]
+	}
 	// body
 	if recv._Get.Body != nil {
-		recv._Get.Results.R0, recv._Get.Results.R1 = recv._Get.Body(p0)
+		results.R0, results.R1 = recv._Get.Body(p0)
+		recv._Get.Results = results
 	}
 	// call history
 	recv._Get.History = append(recv._Get.History, struct {
@@ -148,10 +178,10 @@ func (recv *MockcCache) Get(p0 string) (interface{}, error) {
 		}
 	}{
 		Params:  recv._Get.Params,
-		Results: recv._Get.Results,
+		Results: results,
 	})
 	// results
-	return recv._Get.Results.R0, recv._Get.Results.R1
+	return results.R0, results.R1
 }
 
 func (recv *MockcCache) Set(p0 string, p1 interface{}) error {
@@ -163,9 +193,17 @@ func (recv *MockcCache) Set(p0 string, p1 interface{}) error {
 	// params
 	recv._Set.Params.P0 = p0
 	recv._Set.Params.P1 = p1
+	// default results
+	results := recv._Set.Results
+	// results sequence
+	if recv._Set.Body == nil && len(recv._Set.ResultsSeq) > 0 {
+		results = recv._Set.ResultsSeq[0]
+		recv._Set.ResultsSeq = recv._Set.ResultsSeq[1:]
+	}
 	// body
 	if recv._Set.Body != nil {
-		recv._Set.Results.R0 = recv._Set.Body(p0, p1)
+		results.R0 = recv._Set.Body(p0, p1)
+		recv._Set.Results = results
 	}
 	// call history
 	recv._Set.History = append(recv._Set.History, struct {
@@ -178,8 +216,8 @@ func (recv *MockcCache) Set(p0 string, p1 interface{}) error {
 		}
 	}{
 		Params:  recv._Set.Params,
-		Results: recv._Set.Results,
+		Results: results,
 	})
 	// results
-	return recv._Set.Results.R0
+	return results.R0
 }
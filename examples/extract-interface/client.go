@@ -0,0 +1,38 @@
+package extract
+
+import (
+	"fmt"
+	"net/http"
+)
+
+type Client struct {
+	baseURL string
+	client  *http.Client
+}
+
+func (c *Client) Temperature(city string) (float64, error) {
+	resp, err := c.client.Get(fmt.Sprintf("%s/temperature?city=%s", c.baseURL, city))
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	var temperature float64
+	_, err = fmt.Fscan(resp.Body, &temperature)
+
+	return temperature, err
+}
+
+// Service depends on the interface written by mockc instead of the concrete client.
+type Service struct {
+	Client WeatherClient
+}
+
+func (s Service) IsFreezing(city string) (bool, error) {
+	temperature, err := s.Client.Temperature(city)
+	if err != nil {
+		return false, err
+	}
+
+	return temperature <= 0, nil
+}
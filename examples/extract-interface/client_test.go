@@ -0,0 +1,30 @@
+package extract
+
+import (
+	"testing"
+)
+
+func TestService_IsFreezing(t *testing.T) {
+	m := &MockcClient{}
+
+	// set return value
+	m._Temperature.Results.R0 = -3.5
+
+	// execute
+	s := Service{
+		Client: m,
+	}
+	city := "Seoul"
+	freezing, err := s.IsFreezing(city)
+
+	// assert
+	if !freezing {
+		t.Error("freezing should be true")
+	}
+	if err != nil {
+		t.Error("err should be nil")
+	}
+	if m._Temperature.Params.P0 != city {
+		t.Errorf("Client.Temperature should be called with %q: actual(%q)", city, m._Temperature.Params.P0)
+	}
+}
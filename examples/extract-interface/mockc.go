@@ -0,0 +1,12 @@
+//+build mockc
+
+package extract
+
+import (
+	"github.com/KimMachineGun/mockc"
+)
+
+func MockcClient() {
+	mockc.ExtractInterface(&Client{})
+	mockc.WriteInterface("WeatherClient")
+}
@@ -0,0 +1,87 @@
+// Code generated by Mockc. DO NOT EDIT.
+// repo: https://github.com/KimMachineGun/mockc
+
+//go:generate mockc
+//go:build !mockc
+// +build !mockc
+
+package extract
+
+import "sync"
+
+var _ interface {
+	Temperature(string) (float64, error)
+} = &MockcClient{}
+
+type MockcClient struct {
+	// method: Temperature
+	_Temperature struct {
+		mu sync.Mutex
+		// basics
+		Called    bool
+		CallCount int
+		// call history
+		History []struct {
+			Params struct {
+				P0 string
+			}
+			Results struct {
+				R0 float64
+				R1 error
+			}
+		}
+		// params
+		Params struct {
+			P0 string
+		}
+		// results
+		Results struct {
+			R0 float64
+			R1 error
+		}
+		// if it is not empty, its first element will be consumed instead of the results.
+		ResultsSeq []struct {
+			R0 float64
+			R1 error
+		}
+		// if it is not nil, it'll be called in the middle of the method.
+		Body func(string) (float64, error)
+	}
+}
+
+func (recv *MockcClient) Temperature(p0 string) (float64, error) {
+	recv._Temperature.mu.Lock()
+	defer recv._Temperature.mu.Unlock()
+	// basics
+	recv._Temperature.Called = true
+	recv._Temperature.CallCount++
+	// params
+	recv._Temperature.Params.P0 = p0
+	// default results
+	results := recv._Temperature.Results
+	// results sequence
+	if recv._Temperature.Body == nil && len(recv._Temperature.ResultsSeq) > 0 {
+		results = recv._Temperature.ResultsSeq[0]
+		recv._Temperature.ResultsSeq = recv._Temperature.ResultsSeq[1:]
+	}
+	// body
+	if recv._Temperature.Body != nil {
+		results.R0, results.R1 = recv._Temperature.Body(p0)
+		recv._Temperature.Results = results
+	}
+	// call history
+	recv._Temperature.History = append(recv._Temperature.History, struct {
+		Params struct {
+			P0 string
+		}
+		Results struct {
+			R0 float64
+			R1 error
+		}
+	}{
+		Params:  recv._Temperature.Params,
+		Results: results,
+	})
+	// results
+	return results.R0, results.R1
+}
@@ -0,0 +1,16 @@
+package clock
+
+import (
+	"time"
+)
+
+type Clock func() time.Time
+
+type Token struct {
+	Value     string
+	ExpiresAt time.Time
+}
+
+func (t Token) Expired(now Clock) bool {
+	return !now().Before(t.ExpiresAt)
+}
@@ -0,0 +1,29 @@
+package clock
+
+import (
+	"testing"
+	"time"
+)
+
+func TestToken_Expired(t *testing.T) {
+	m := &MockcClock{}
+
+	// set return value
+	expiresAt := time.Date(2020, time.August, 1, 0, 0, 0, 0, time.UTC)
+	m._Clock.Results.R0 = expiresAt.Add(time.Second)
+
+	// execute
+	token := Token{
+		Value:     "token",
+		ExpiresAt: expiresAt,
+	}
+	expired := token.Expired(m.Func())
+
+	// assert
+	if !expired {
+		t.Error("token should be expired")
+	}
+	if m._Clock.CallCount != 1 {
+		t.Errorf("Clock should be called once: actual(%d)", m._Clock.CallCount)
+	}
+}
@@ -0,0 +1,73 @@
+// Code generated by Mockc. DO NOT EDIT.
+// repo: https://github.com/KimMachineGun/mockc
+
+//go:generate mockc
+//go:build !mockc
+// +build !mockc
+
+package clock
+
+import (
+	"sync"
+	"time"
+)
+
+var _ Clock = (&MockcClock{}).Clock
+
+type MockcClock struct {
+	// method: Clock
+	_Clock struct {
+		mu sync.Mutex
+		// basics
+		Called    bool
+		CallCount int
+		// call history
+		History []struct {
+			Results struct {
+				R0 time.Time
+			}
+		}
+		// results
+		Results struct {
+			R0 time.Time
+		}
+		// if it is not empty, its first element will be consumed instead of the results.
+		ResultsSeq []struct {
+			R0 time.Time
+		}
+		// if it is not nil, it'll be called in the middle of the method.
+		Body func() time.Time
+	}
+}
+
+func (recv *MockcClock) Clock() time.Time {
+	recv._Clock.mu.Lock()
+	defer recv._Clock.mu.Unlock()
+	// basics
+	recv._Clock.Called = true
+	recv._Clock.CallCount++
+	// default results
+	results := recv._Clock.Results
+	// results sequence
+	if recv._Clock.Body == nil && len(recv._Clock.ResultsSeq) > 0 {
+		results = recv._Clock.ResultsSeq[0]
+		recv._Clock.ResultsSeq = recv._Clock.ResultsSeq[1:]
+	}
+	// body
+	if recv._Clock.Body != nil {
+		results.R0 = recv._Clock.Body()
+		recv._Clock.Results = results
+	}
+	// call history
+	recv._Clock.History = append(recv._Clock.History, struct {
+		Results struct {
+			R0 time.Time
+		}
+	}{Results: results})
+	// results
+	return results.R0
+}
+
+func (recv *MockcClock) Func() Clock {
+	return recv.Clock
+}
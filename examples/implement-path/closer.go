@@ -0,0 +1,15 @@
+package path
+
+import (
+	"io"
+)
+
+func WriteAndClose(wc io.WriteCloser, p []byte) error {
+	_, err := wc.Write(p)
+	if err != nil {
+		wc.Close()
+		return err
+	}
+
+	return wc.Close()
+}
@@ -0,0 +1,42 @@
+package path
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestWriteAndClose(t *testing.T) {
+	m := &MockcWriteCloser{}
+
+	// execute
+	err := WriteAndClose(m, []byte("test"))
+
+	// assert
+	if err != nil {
+		t.Error("err should be nil")
+	}
+	if string(m._Write.Params.P0) != "test" {
+		t.Errorf("Write should be called with %q: actual(%q)", "test", m._Write.Params.P0)
+	}
+	if !m._Close.Called {
+		t.Error("Close should be called")
+	}
+}
+
+func TestWriteAndClose_WriteFailed(t *testing.T) {
+	m := &MockcWriteCloser{}
+
+	// set return value
+	m._Write.Results.R1 = errors.New("write failed")
+
+	// execute
+	err := WriteAndClose(m, []byte("test"))
+
+	// assert
+	if err == nil || err.Error() != "write failed" {
+		t.Errorf("err should be %q: actual(%v)", "write failed", err)
+	}
+	if !m._Close.Called {
+		t.Error("Close should be called even if Write failed")
+	}
+}
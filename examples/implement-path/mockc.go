@@ -0,0 +1,11 @@
+//+build mockc
+
+package path
+
+import (
+	"github.com/KimMachineGun/mockc"
+)
+
+func MockcWriteCloser() {
+	mockc.ImplementPath("io.WriteCloser")
+}
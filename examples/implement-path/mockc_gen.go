@@ -0,0 +1,141 @@
+// Code generated by Mockc. DO NOT EDIT.
+// repo: https://github.com/KimMachineGun/mockc
+
+//go:generate mockc
+//go:build !mockc
+// +build !mockc
+
+package path
+
+import (
+	"io"
+	"sync"
+)
+
+var _ interface {
+	io.WriteCloser
+} = &MockcWriteCloser{}
+
+type MockcWriteCloser struct {
+	// method: Close
+	_Close struct {
+		mu sync.Mutex
+		// basics
+		Called    bool
+		CallCount int
+		// call history
+		History []struct {
+			Results struct {
+				R0 error
+			}
+		}
+		// results
+		Results struct {
+			R0 error
+		}
+		// if it is not empty, its first element will be consumed instead of the results.
+		ResultsSeq []struct {
+			R0 error
+		}
+		// if it is not nil, it'll be called in the middle of the method.
+		Body func() error
+	}
+	// method: Write
+	_Write struct {
+		mu sync.Mutex
+		// basics
+		Called    bool
+		CallCount int
+		// call history
+		History []struct {
+			Params struct {
+				P0 []byte
+			}
+			Results struct {
+				R0 int
+				R1 error
+			}
+		}
+		// params
+		Params struct {
+			P0 []byte
+		}
+		// results
+		Results struct {
+			R0 int
+			R1 error
+		}
+		// if it is not empty, its first element will be consumed instead of the results.
+		ResultsSeq []struct {
+			R0 int
+			R1 error
+		}
+		// if it is not nil, it'll be called in the middle of the method.
+		Body func([]byte) (int, error)
+	}
+}
+
+func (recv *MockcWriteCloser) Close() error {
+	recv._Close.mu.Lock()
+	defer recv._Close.mu.Unlock()
+	// basics
+	recv._Close.Called = true
+	recv._Close.CallCount++
+	// default results
+	results := recv._Close.Results
+	// results sequence
+	if recv._Close.Body == nil && len(recv._Close.ResultsSeq) > 0 {
+		results = recv._Close.ResultsSeq[0]
+		recv._Close.ResultsSeq = recv._Close.ResultsSeq[1:]
+	}
+	// body
+	if recv._Close.Body != nil {
+		results.R0 = recv._Close.Body()
+		recv._Close.Results = results
+	}
+	// call history
+	recv._Close.History = append(recv._Close.History, struct {
+		Results struct {
+			R0 error
+		}
+	}{Results: results})
+	// results
+	return results.R0
+}
+
+func (recv *MockcWriteCloser) Write(p0 []byte) (int, error) {
+	recv._Write.mu.Lock()
+	defer recv._Write.mu.Unlock()
+	// basics
+	recv._Write.Called = true
+	recv._Write.CallCount++
+	// params
+	recv._Write.Params.P0 = p0
+	// default results
+	results := recv._Write.Results
+	// results sequence
+	if recv._Write.Body == nil && len(recv._Write.ResultsSeq) > 0 {
+		results = recv._Write.ResultsSeq[0]
+		recv._Write.ResultsSeq = recv._Write.ResultsSeq[1:]
+	}
+	// body
+	if recv._Write.Body != nil {
+		results.R0, results.R1 = recv._Write.Body(p0)
+		recv._Write.Results = results
+	}
+	// call history
+	recv._Write.History = append(recv._Write.History, struct {
+		Params struct {
+			P0 []byte
+		}
+		Results struct {
+			R0 int
+			R1 error
+		}
+	}{
+		Params:  recv._Write.Params,
+		Results: results,
+	})
+	// results
+	return results.R0, results.R1
+}
@@ -0,0 +1,68 @@
+// Package recorder provides testing.TB that records the reported errors instead of failing the test,
+// so that the examples can show how the generated mocks report the failures.
+package recorder
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// TB records the failures reported through it and the functions registered by Cleanup.
+// Fatal, Fatalf and FailNow record the failures without stopping the test, unlike testing.T.
+type TB struct {
+	testing.TB
+	Errors   []string
+	failed   bool
+	cleanups []func()
+}
+
+// New returns TB wrapping t.
+func New(t testing.TB) *TB {
+	return &TB{TB: t}
+}
+
+func (r *TB) Helper() {}
+
+func (r *TB) Cleanup(f func()) {
+	r.cleanups = append(r.cleanups, f)
+}
+
+func (r *TB) Error(args ...interface{}) {
+	// testing.T formats the args as fmt.Sprintln does
+	r.Errors = append(r.Errors, strings.TrimSuffix(fmt.Sprintln(args...), "\n"))
+	r.failed = true
+}
+
+func (r *TB) Errorf(format string, args ...interface{}) {
+	r.Errors = append(r.Errors, fmt.Sprintf(format, args...))
+	r.failed = true
+}
+
+func (r *TB) Fatal(args ...interface{}) {
+	r.Error(args...)
+}
+
+func (r *TB) Fatalf(format string, args ...interface{}) {
+	r.Errorf(format, args...)
+}
+
+func (r *TB) Fail() {
+	r.failed = true
+}
+
+func (r *TB) FailNow() {
+	r.failed = true
+}
+
+func (r *TB) Failed() bool {
+	return r.failed
+}
+
+// RunCleanups runs the registered functions in the reverse order, as testing.T does when the test finishes.
+func (r *TB) RunCleanups() {
+	for i := len(r.cleanups) - 1; i >= 0; i-- {
+		r.cleanups[i]()
+	}
+	r.cleanups = nil
+}
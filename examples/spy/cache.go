@@ -0,0 +1,28 @@
+package spy
+
+import (
+	"errors"
+)
+
+var ErrNotFound = errors.New("not found")
+
+type Cache interface {
+	Get(key string) (val interface{}, err error)
+	Set(key string, val interface{}) (err error)
+}
+
+type MapCache map[string]interface{}
+
+func (c MapCache) Get(key string) (interface{}, error) {
+	val, ok := c[key]
+	if !ok {
+		return nil, ErrNotFound
+	}
+
+	return val, nil
+}
+
+func (c MapCache) Set(key string, val interface{}) error {
+	c[key] = val
+	return nil
+}
@@ -0,0 +1,140 @@
+package spy
+
+import (
+	"errors"
+	"testing"
+)
+
+func GetOrSet(c Cache, key string, val interface{}) (interface{}, error) {
+	v, err := c.Get(key)
+	if errors.Is(err, ErrNotFound) {
+		return val, c.Set(key, val)
+	}
+
+	return v, err
+}
+
+func TestGetOrSet(t *testing.T) {
+	// every call is forwarded to the delegate by default
+	m := NewMockcCache(MapCache{})
+
+	// execute
+	val, err := GetOrSet(m, "key", "val")
+
+	// assert
+	if err != nil {
+		t.Errorf("err should be nil: actual(%v)", err)
+	}
+	if val != "val" {
+		t.Errorf("val should be %q: actual(%v)", "val", val)
+	}
+	if m._Set.CallCount != 1 {
+		t.Errorf("Cache.Set should be called once: actual(%d)", m._Set.CallCount)
+	}
+	if stored, _ := m.Delegate().Get("key"); stored != "val" {
+		t.Errorf("delegate should store %q: actual(%v)", "val", stored)
+	}
+}
+
+func TestGetOrSet_Override(t *testing.T) {
+	m := NewMockcCache(MapCache{})
+
+	// override the Get method
+	errUnavailable := errors.New("unavailable")
+	m._Get.Body = func(key string) (interface{}, error) {
+		return nil, errUnavailable
+	}
+
+	// execute
+	_, err := GetOrSet(m, "key", "val")
+
+	// assert
+	if err != errUnavailable {
+		t.Errorf("err should be %v: actual(%v)", errUnavailable, err)
+	}
+	if m._Set.Called {
+		t.Error("Cache.Set should not be called")
+	}
+
+	// restore the forwarding to the delegate
+	m.RestoreGet()
+
+	// execute
+	val, err := GetOrSet(m, "key", "val")
+
+	// assert
+	if err != nil {
+		t.Errorf("err should be nil: actual(%v)", err)
+	}
+	if val != "val" {
+		t.Errorf("val should be %q: actual(%v)", "val", val)
+	}
+	if m._Get.CallCount != 2 {
+		t.Errorf("Cache.Get should be called twice: actual(%d)", m._Get.CallCount)
+	}
+}
+
+func TestGetOrSet_ResultsSeq(t *testing.T) {
+	m := NewMockcCache(MapCache{})
+
+	// the entries of ResultsSeq take precedence over the delegate
+	m._Get.ResultsSeq = append(m._Get.ResultsSeq, struct {
+		R0 interface{}
+		R1 error
+	}{R0: "cached"})
+
+	// execute
+	val, err := GetOrSet(m, "key", "val")
+
+	// assert
+	if err != nil {
+		t.Errorf("err should be nil: actual(%v)", err)
+	}
+	if val != "cached" {
+		t.Errorf("val should be %q: actual(%v)", "cached", val)
+	}
+	if m._Set.Called {
+		t.Error("Cache.Set should not be called")
+	}
+
+	// execute after the sequence is consumed
+	val, err = GetOrSet(m, "key", "val")
+
+	// assert
+	if err != nil {
+		t.Errorf("err should be nil: actual(%v)", err)
+	}
+	if val != "val" {
+		t.Errorf("val should be %q: actual(%v)", "val", val)
+	}
+	if m._Set.CallCount != 1 {
+		t.Errorf("Cache.Set should be called once: actual(%d)", m._Set.CallCount)
+	}
+}
+
+func TestGetOrSet_Expectations(t *testing.T) {
+	m := NewMockcCache(MapCache{"key": "real"})
+
+	// the results of the matched expectation take precedence over the delegate
+	m.ExpectGet().WithP0("key").Return("expected", nil)
+	m._Get.ResultsSeq = append(m._Get.ResultsSeq, struct {
+		R0 interface{}
+		R1 error
+	}{R0: "cached"})
+
+	// execute
+	val, err := GetOrSet(m, "key", "val")
+
+	// assert
+	if err != nil {
+		t.Errorf("err should be nil: actual(%v)", err)
+	}
+	if val != "expected" {
+		t.Errorf("val should be %q: actual(%v)", "expected", val)
+	}
+	if len(m._Get.ResultsSeq) != 1 {
+		t.Errorf("ResultsSeq should not be consumed: actual(%d)", len(m._Get.ResultsSeq))
+	}
+
+	m.AssertExpectations(t)
+}
@@ -0,0 +1,13 @@
+//+build mockc
+
+package spy
+
+import (
+	"github.com/KimMachineGun/mockc"
+)
+
+func MockcCache() {
+	mockc.Implement(Cache(nil))
+	mockc.AsSpy()
+	mockc.WithExpectations()
+}
@@ -0,0 +1,430 @@
+// Code generated by Mockc. DO NOT EDIT.
+// repo: https://github.com/KimMachineGun/mockc
+
+//go:generate mockc
+//go:build !mockc
+// +build !mockc
+
+package spy
+
+import (
+	"fmt"
+	mockc "github.com/KimMachineGun/mockc"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+)
+
+var _ interface {
+	Cache
+} = &MockcCache{}
+
+type MockcCache struct {
+	delegate interface {
+		Cache
+	}
+	// method: Get
+	_Get struct {
+		mu sync.Mutex
+		// basics
+		Called    bool
+		CallCount int
+		// call history
+		History []struct {
+			Params struct {
+				P0 string
+			}
+			Results struct {
+				R0 interface{}
+				R1 error
+			}
+		}
+		// params
+		Params struct {
+			P0 string
+		}
+		// results
+		Results struct {
+			R0 interface{}
+			R1 error
+		}
+		// if it is not empty, its first element will be consumed instead of the results.
+		ResultsSeq []struct {
+			R0 interface{}
+			R1 error
+		}
+		// expectations
+		expectations    []*MockcCacheGetExpectation
+		unexpectedCalls []string
+		// if it is not nil, it'll be called in the middle of the method.
+		Body func(string) (interface{}, error)
+	}
+	// method: Set
+	_Set struct {
+		mu sync.Mutex
+		// basics
+		Called    bool
+		CallCount int
+		// call history
+		History []struct {
+			Params struct {
+				P0 string
+				P1 interface{}
+			}
+			Results struct {
+				R0 error
+			}
+		}
+		// params
+		Params struct {
+			P0 string
+			P1 interface{}
+		}
+		// results
+		Results struct {
+			R0 error
+		}
+		// if it is not empty, its first element will be consumed instead of the results.
+		ResultsSeq []struct {
+			R0 error
+		}
+		// expectations
+		expectations    []*MockcCacheSetExpectation
+		unexpectedCalls []string
+		// if it is not nil, it'll be called in the middle of the method.
+		Body func(string, interface{}) error
+	}
+}
+
+func NewMockcCache(delegate interface {
+	Cache
+}) *MockcCache {
+	m := &MockcCache{delegate: delegate}
+	return m
+}
+
+func (recv *MockcCache) Get(p0 string) (interface{}, error) {
+	recv._Get.mu.Lock()
+	defer recv._Get.mu.Unlock()
+	// basics
+	recv._Get.Called = true
+	recv._Get.CallCount++
+	// params
+	recv._Get.Params.P0 = p0
+	// default results
+	results := recv._Get.Results
+	supplied := false
+	// expectations
+	matched := false
+	for _, expectation := range recv._Get.expectations {
+		if !expectation.match(p0) {
+			continue
+		}
+		matched = true
+		expectation.calls++
+		if expectation.returns {
+			results = expectation.results
+			supplied = true
+		}
+		break
+	}
+	if !matched {
+		recv._Get.unexpectedCalls = append(recv._Get.unexpectedCalls, mockc.FormatCall("Get", p0))
+	}
+	// results sequence
+	if !supplied && recv._Get.Body == nil && len(recv._Get.ResultsSeq) > 0 {
+		results = recv._Get.ResultsSeq[0]
+		recv._Get.ResultsSeq = recv._Get.ResultsSeq[1:]
+		supplied = true
+	}
+	// body
+	if recv._Get.Body != nil {
+		results.R0, results.R1 = recv._Get.Body(p0)
+		recv._Get.Results = results
+	} else if recv.delegate != nil && !supplied {
+		results.R0, results.R1 = recv.delegate.Get(p0)
+	}
+	// call history
+	recv._Get.History = append(recv._Get.History, struct {
+		Params struct {
+			P0 string
+		}
+		Results struct {
+			R0 interface{}
+			R1 error
+		}
+	}{
+		Params:  recv._Get.Params,
+		Results: results,
+	})
+	// results
+	return results.R0, results.R1
+}
+
+func (recv *MockcCache) Set(p0 string, p1 interface{}) error {
+	recv._Set.mu.Lock()
+	defer recv._Set.mu.Unlock()
+	// basics
+	recv._Set.Called = true
+	recv._Set.CallCount++
+	// params
+	recv._Set.Params.P0 = p0
+	recv._Set.Params.P1 = p1
+	// default results
+	results := recv._Set.Results
+	supplied := false
+	// expectations
+	matched := false
+	for _, expectation := range recv._Set.expectations {
+		if !expectation.match(p0, p1) {
+			continue
+		}
+		matched = true
+		expectation.calls++
+		if expectation.returns {
+			results = expectation.results
+			supplied = true
+		}
+		break
+	}
+	if !matched {
+		recv._Set.unexpectedCalls = append(recv._Set.unexpectedCalls, mockc.FormatCall("Set", p0, p1))
+	}
+	// results sequence
+	if !supplied && recv._Set.Body == nil && len(recv._Set.ResultsSeq) > 0 {
+		results = recv._Set.ResultsSeq[0]
+		recv._Set.ResultsSeq = recv._Set.ResultsSeq[1:]
+		supplied = true
+	}
+	// body
+	if recv._Set.Body != nil {
+		results.R0 = recv._Set.Body(p0, p1)
+		recv._Set.Results = results
+	} else if recv.delegate != nil && !supplied {
+		results.R0 = recv.delegate.Set(p0, p1)
+	}
+	// call history
+	recv._Set.History = append(recv._Set.History, struct {
+		Params struct {
+			P0 string
+			P1 interface{}
+		}
+		Results struct {
+			R0 error
+		}
+	}{
+		Params:  recv._Set.Params,
+		Results: results,
+	})
+	// results
+	return results.R0
+}
+
+type MockcCacheGetExpectation struct {
+	matchers struct {
+		P0 func(string) bool
+	}
+	descriptions [1]string
+	returns      bool
+	results      struct {
+		R0 interface{}
+		R1 error
+	}
+	// it is -1 if the method is expected to be called at least once.
+	times int
+	calls int
+}
+
+func (recv *MockcCache) ExpectGet() *MockcCacheGetExpectation {
+	recv._Get.mu.Lock()
+	defer recv._Get.mu.Unlock()
+	e := &MockcCacheGetExpectation{times: -1}
+	recv._Get.expectations = append(recv._Get.expectations, e)
+	return e
+}
+
+func (e *MockcCacheGetExpectation) WithP0(v string) *MockcCacheGetExpectation {
+	e.matchers.P0 = func(actual string) bool {
+		return reflect.DeepEqual(actual, v)
+	}
+	e.descriptions[0] = mockc.FormatValue(v)
+	return e
+}
+
+func (e *MockcCacheGetExpectation) WithP0Func(match func(string) bool) *MockcCacheGetExpectation {
+	e.matchers.P0 = match
+	e.descriptions[0] = "<func>"
+	return e
+}
+
+func (e *MockcCacheGetExpectation) Return(r0 interface{}, r1 error) *MockcCacheGetExpectation {
+	e.returns = true
+	e.results.R0 = r0
+	e.results.R1 = r1
+	return e
+}
+
+func (e *MockcCacheGetExpectation) Times(n int) *MockcCacheGetExpectation {
+	if n < 0 {
+		panic(fmt.Sprintf("MockcCache.%s: Times(%d): the number of calls must not be negative", e.describe(), n))
+	}
+	e.times = n
+	return e
+}
+
+func (e *MockcCacheGetExpectation) match(p0 string) bool {
+	if e.times > 0 && e.calls >= e.times {
+		return false
+	}
+	if e.matchers.P0 != nil && !e.matchers.P0(p0) {
+		return false
+	}
+	return true
+}
+
+func (e *MockcCacheGetExpectation) describe() string {
+	args := make([]string, len(e.descriptions))
+	for i, description := range e.descriptions {
+		if description == "" {
+			description = "_"
+		}
+		args[i] = description
+	}
+	return "Get(" + strings.Join(args, ", ") + ")"
+}
+
+type MockcCacheSetExpectation struct {
+	matchers struct {
+		P0 func(string) bool
+		P1 func(interface{}) bool
+	}
+	descriptions [2]string
+	returns      bool
+	results      struct {
+		R0 error
+	}
+	// it is -1 if the method is expected to be called at least once.
+	times int
+	calls int
+}
+
+func (recv *MockcCache) ExpectSet() *MockcCacheSetExpectation {
+	recv._Set.mu.Lock()
+	defer recv._Set.mu.Unlock()
+	e := &MockcCacheSetExpectation{times: -1}
+	recv._Set.expectations = append(recv._Set.expectations, e)
+	return e
+}
+
+func (e *MockcCacheSetExpectation) WithP0(v string) *MockcCacheSetExpectation {
+	e.matchers.P0 = func(actual string) bool {
+		return reflect.DeepEqual(actual, v)
+	}
+	e.descriptions[0] = mockc.FormatValue(v)
+	return e
+}
+
+func (e *MockcCacheSetExpectation) WithP0Func(match func(string) bool) *MockcCacheSetExpectation {
+	e.matchers.P0 = match
+	e.descriptions[0] = "<func>"
+	return e
+}
+
+func (e *MockcCacheSetExpectation) WithP1(v interface{}) *MockcCacheSetExpectation {
+	e.matchers.P1 = func(actual interface{}) bool {
+		return reflect.DeepEqual(actual, v)
+	}
+	e.descriptions[1] = mockc.FormatValue(v)
+	return e
+}
+
+func (e *MockcCacheSetExpectation) WithP1Func(match func(interface{}) bool) *MockcCacheSetExpectation {
+	e.matchers.P1 = match
+	e.descriptions[1] = "<func>"
+	return e
+}
+
+func (e *MockcCacheSetExpectation) Return(r0 error) *MockcCacheSetExpectation {
+	e.returns = true
+	e.results.R0 = r0
+	return e
+}
+
+func (e *MockcCacheSetExpectation) Times(n int) *MockcCacheSetExpectation {
+	if n < 0 {
+		panic(fmt.Sprintf("MockcCache.%s: Times(%d): the number of calls must not be negative", e.describe(), n))
+	}
+	e.times = n
+	return e
+}
+
+func (e *MockcCacheSetExpectation) match(p0 string, p1 interface{}) bool {
+	if e.times > 0 && e.calls >= e.times {
+		return false
+	}
+	if e.matchers.P0 != nil && !e.matchers.P0(p0) {
+		return false
+	}
+	if e.matchers.P1 != nil && !e.matchers.P1(p1) {
+		return false
+	}
+	return true
+}
+
+func (e *MockcCacheSetExpectation) describe() string {
+	args := make([]string, len(e.descriptions))
+	for i, description := range e.descriptions {
+		if description == "" {
+			description = "_"
+		}
+		args[i] = description
+	}
+	return "Set(" + strings.Join(args, ", ") + ")"
+}
+
+func (recv *MockcCache) AssertExpectations(t testing.TB) {
+	t.Helper()
+	recv._Get.mu.Lock()
+	for _, e := range recv._Get.expectations {
+		if e.times >= 0 && e.calls != e.times {
+			t.Errorf("MockcCache.%s: expected %d call(s), but got %d", e.describe(), e.times, e.calls)
+		} else if e.times < 0 && e.calls == 0 {
+			t.Errorf("MockcCache.%s: expected at least one call, but got none", e.describe())
+		}
+	}
+	for _, call := range recv._Get.unexpectedCalls {
+		t.Errorf("MockcCache.%s: unexpected call", call)
+	}
+	recv._Get.mu.Unlock()
+	recv._Set.mu.Lock()
+	for _, e := range recv._Set.expectations {
+		if e.times >= 0 && e.calls != e.times {
+			t.Errorf("MockcCache.%s: expected %d call(s), but got %d", e.describe(), e.times, e.calls)
+		} else if e.times < 0 && e.calls == 0 {
+			t.Errorf("MockcCache.%s: expected at least one call, but got none", e.describe())
+		}
+	}
+	for _, call := range recv._Set.unexpectedCalls {
+		t.Errorf("MockcCache.%s: unexpected call", call)
+	}
+	recv._Set.mu.Unlock()
+}
+
+func (recv *MockcCache) Delegate() interface {
+	Cache
+} {
+	return recv.delegate
+}
+
+func (recv *MockcCache) RestoreGet() {
+	recv._Get.mu.Lock()
+	defer recv._Get.mu.Unlock()
+	recv._Get.Body = nil
+}
+
+func (recv *MockcCache) RestoreSet() {
+	recv._Set.mu.Lock()
+	defer recv._Set.mu.Unlock()
+	recv._Set.Body = nil
+}
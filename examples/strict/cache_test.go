@@ -0,0 +1,76 @@
+package strict
+
+import (
+	"testing"
+)
+
+func HasKey(c Cache, key string) (bool, error) {
+	val, err := c.Get(key)
+	if err != nil {
+		return false, err
+	}
+
+	return val != nil, nil
+}
+
+func TestHasKey_Strict(t *testing.T) {
+	m := &MockcCache{}
+
+	// set return value
+	m._Get.Results.R0 = struct{}{}
+
+	// execute
+	result, err := HasKey(m, "test_key")
+
+	// assert
+	if !result {
+		t.Error("result should be true")
+	}
+	if err != nil {
+		t.Error("err should be nil")
+	}
+}
+
+func TestHasKey_StrictWithoutResults(t *testing.T) {
+	m := &MockcCache{}
+
+	defer func() {
+		expected := `MockcCache.Get("test_key"): called without configured behavior`
+		if r := recover(); r != expected {
+			t.Errorf("HasKey should panic with %q: actual(%v)", expected, r)
+		}
+	}()
+
+	// execute without configuring Cache.Get
+	HasKey(m, "test_key")
+}
+
+func TestHasKey_StrictWithZeroResults(t *testing.T) {
+	m := &MockcCache{}
+
+	// the results set by SetGetResults are regarded as configured even if they are zero
+	m.SetGetResults(nil, nil)
+
+	// execute
+	result, err := HasKey(m, "test_key")
+
+	// assert
+	if result {
+		t.Error("result should be false")
+	}
+	if err != nil {
+		t.Error("err should be nil")
+	}
+}
+
+func TestFlush_StrictWithoutResults(t *testing.T) {
+	m := &MockcCache{}
+
+	// the methods without results don't need to be configured
+	m.Flush()
+
+	// assert
+	if m._Flush.CallCount != 1 {
+		t.Error("Flush should be called once")
+	}
+}
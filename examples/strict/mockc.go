@@ -0,0 +1,12 @@
+//+build mockc
+
+package strict
+
+import (
+	"github.com/KimMachineGun/mockc"
+)
+
+func MockcCache() {
+	mockc.Implement(Cache(nil))
+	mockc.Strict()
+}
@@ -0,0 +1,39 @@
+package accessors
+
+import (
+	"sync"
+)
+
+type Cache interface {
+	Get(key string) (val interface{}, err error)
+	Set(key string, val interface{}) (err error)
+}
+
+// Warm sets the values to the cache concurrently.
+func Warm(c Cache, values map[string]interface{}) error {
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs []error
+	)
+	for key, val := range values {
+		wg.Add(1)
+		go func(key string, val interface{}) {
+			defer wg.Done()
+
+			err := c.Set(key, val)
+			if err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+			}
+		}(key, val)
+	}
+	wg.Wait()
+
+	if len(errs) > 0 {
+		return errs[0]
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package accessors
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestWarm(t *testing.T) {
+	m := &MockcCache{}
+
+	// execute
+	values := map[string]interface{}{
+		"a": 1,
+		"b": 2,
+		"c": 3,
+	}
+	err := Warm(m, values)
+
+	// assert
+	if err != nil {
+		t.Error("err should be nil")
+	}
+	if m.SetCallCount() != len(values) {
+		t.Errorf("Cache.Set should be called %d times: actual(%d)", len(values), m.SetCallCount())
+	}
+	for _, call := range m.SetHistory() {
+		if values[call.Params.P0] != call.Params.P1 {
+			t.Errorf("Cache.Set should be called with %v for %q: actual(%v)", values[call.Params.P0], call.Params.P0, call.Params.P1)
+		}
+	}
+}
+
+func TestWarm_Error(t *testing.T) {
+	m := &MockcCache{}
+
+	// set return value
+	errUnavailable := errors.New("unavailable")
+	m.SetSetResults(errUnavailable)
+
+	// execute
+	err := Warm(m, map[string]interface{}{
+		"a": 1,
+	})
+
+	// assert
+	if err != errUnavailable {
+		t.Errorf("err should be %v: actual(%v)", errUnavailable, err)
+	}
+}
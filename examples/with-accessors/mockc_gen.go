@@ -0,0 +1,266 @@
+// Code generated by Mockc. DO NOT EDIT.
+// repo: https://github.com/KimMachineGun/mockc
+
+//go:generate mockc
+//go:build !mockc
+// +build !mockc
+
+package accessors
+
+import "sync"
+
+var _ interface {
+	Cache
+} = &MockcCache{}
+
+type MockcCache struct {
+	// method: Get
+	_Get struct {
+		mu sync.Mutex
+		// basics
+		called    bool
+		callCount int
+		// call history
+		history []struct {
+			Params struct {
+				P0 string
+			}
+			Results struct {
+				R0 interface{}
+				R1 error
+			}
+		}
+		// params
+		params struct {
+			P0 string
+		}
+		// results
+		results struct {
+			R0 interface{}
+			R1 error
+		}
+		// if it is not empty, its first element will be consumed instead of the results.
+		resultsSeq []struct {
+			R0 interface{}
+			R1 error
+		}
+		// if it is not nil, it'll be called in the middle of the method.
+		body func(string) (interface{}, error)
+	}
+	// method: Set
+	_Set struct {
+		mu sync.Mutex
+		// basics
+		called    bool
+		callCount int
+		// call history
+		history []struct {
+			Params struct {
+				P0 string
+				P1 interface{}
+			}
+			Results struct {
+				R0 error
+			}
+		}
+		// params
+		params struct {
+			P0 string
+			P1 interface{}
+		}
+		// results
+		results struct {
+			R0 error
+		}
+		// if it is not empty, its first element will be consumed instead of the results.
+		resultsSeq []struct {
+			R0 error
+		}
+		// if it is not nil, it'll be called in the middle of the method.
+		body func(string, interface{}) error
+	}
+}
+
+func (recv *MockcCache) Get(p0 string) (interface{}, error) {
+	recv._Get.mu.Lock()
+	defer recv._Get.mu.Unlock()
+	// basics
+	recv._Get.called = true
+	recv._Get.callCount++
+	// params
+	recv._Get.params.P0 = p0
+	// default results
+	results := recv._Get.results
+	// results sequence
+	if recv._Get.body == nil && len(recv._Get.resultsSeq) > 0 {
+		results = recv._Get.resultsSeq[0]
+		recv._Get.resultsSeq = recv._Get.resultsSeq[1:]
+	}
+	// body
+	if recv._Get.body != nil {
+		results.R0, results.R1 = recv._Get.body(p0)
+		recv._Get.results = results
+	}
+	// call history
+	recv._Get.history = append(recv._Get.history, struct {
+		Params struct {
+			P0 string
+		}
+		Results struct {
+			R0 interface{}
+			R1 error
+		}
+	}{
+		Params:  recv._Get.params,
+		Results: results,
+	})
+	// results
+	return results.R0, results.R1
+}
+
+func (recv *MockcCache) Set(p0 string, p1 interface{}) error {
+	recv._Set.mu.Lock()
+	defer recv._Set.mu.Unlock()
+	// basics
+	recv._Set.called = true
+	recv._Set.callCount++
+	// params
+	recv._Set.params.P0 = p0
+	recv._Set.params.P1 = p1
+	// default results
+	results := recv._Set.results
+	// results sequence
+	if recv._Set.body == nil && len(recv._Set.resultsSeq) > 0 {
+		results = recv._Set.resultsSeq[0]
+		recv._Set.resultsSeq = recv._Set.resultsSeq[1:]
+	}
+	// body
+	if recv._Set.body != nil {
+		results.R0 = recv._Set.body(p0, p1)
+		recv._Set.results = results
+	}
+	// call history
+	recv._Set.history = append(recv._Set.history, struct {
+		Params struct {
+			P0 string
+			P1 interface{}
+		}
+		Results struct {
+			R0 error
+		}
+	}{
+		Params:  recv._Set.params,
+		Results: results,
+	})
+	// results
+	return results.R0
+}
+
+func (recv *MockcCache) GetCallCount() int {
+	recv._Get.mu.Lock()
+	defer recv._Get.mu.Unlock()
+	return recv._Get.callCount
+}
+
+func (recv *MockcCache) GetParams() struct {
+	P0 string
+} {
+	recv._Get.mu.Lock()
+	defer recv._Get.mu.Unlock()
+	return recv._Get.params
+}
+
+func (recv *MockcCache) GetHistory() []struct {
+	Params struct {
+		P0 string
+	}
+	Results struct {
+		R0 interface{}
+		R1 error
+	}
+} {
+	recv._Get.mu.Lock()
+	defer recv._Get.mu.Unlock()
+	return append(recv._Get.history[:0:0], recv._Get.history...)
+}
+
+func (recv *MockcCache) SetGetResults(r0 interface{}, r1 error) {
+	recv._Get.mu.Lock()
+	defer recv._Get.mu.Unlock()
+	recv._Get.results = struct {
+		R0 interface{}
+		R1 error
+	}{
+		R0: r0,
+		R1: r1,
+	}
+}
+
+func (recv *MockcCache) AppendGetResults(r0 interface{}, r1 error) {
+	recv._Get.mu.Lock()
+	defer recv._Get.mu.Unlock()
+	recv._Get.resultsSeq = append(recv._Get.resultsSeq, struct {
+		R0 interface{}
+		R1 error
+	}{
+		R0: r0,
+		R1: r1,
+	})
+}
+
+func (recv *MockcCache) SetGetBody(body func(string) (interface{}, error)) {
+	recv._Get.mu.Lock()
+	defer recv._Get.mu.Unlock()
+	recv._Get.body = body
+}
+
+func (recv *MockcCache) SetCallCount() int {
+	recv._Set.mu.Lock()
+	defer recv._Set.mu.Unlock()
+	return recv._Set.callCount
+}
+
+func (recv *MockcCache) SetParams() struct {
+	P0 string
+	P1 interface{}
+} {
+	recv._Set.mu.Lock()
+	defer recv._Set.mu.Unlock()
+	return recv._Set.params
+}
+
+func (recv *MockcCache) SetHistory() []struct {
+	Params struct {
+		P0 string
+		P1 interface{}
+	}
+	Results struct {
+		R0 error
+	}
+} {
+	recv._Set.mu.Lock()
+	defer recv._Set.mu.Unlock()
+	return append(recv._Set.history[:0:0], recv._Set.history...)
+}
+
+func (recv *MockcCache) SetSetResults(r0 error) {
+	recv._Set.mu.Lock()
+	defer recv._Set.mu.Unlock()
+	recv._Set.results = struct {
+		R0 error
+	}{R0: r0}
+}
+
+func (recv *MockcCache) AppendSetResults(r0 error) {
+	recv._Set.mu.Lock()
+	defer recv._Set.mu.Unlock()
+	recv._Set.resultsSeq = append(recv._Set.resultsSeq, struct {
+		R0 error
+	}{R0: r0})
+}
+
+func (recv *MockcCache) SetSetBody(body func(string, interface{}) error) {
+	recv._Set.mu.Lock()
+	defer recv._Set.mu.Unlock()
+	recv._Set.body = body
+}
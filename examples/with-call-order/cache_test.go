@@ -0,0 +1,87 @@
+package order
+
+import (
+	"testing"
+
+	"github.com/KimMachineGun/mockc"
+	"github.com/KimMachineGun/mockc/examples/internal/recorder"
+)
+
+func GetOrLoad(c Cache, s Store, key string) (interface{}, error) {
+	val, err := c.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	if val != nil {
+		return val, nil
+	}
+
+	val, err = s.Load(key)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.Set(key, val)
+	if err != nil {
+		return nil, err
+	}
+
+	return val, nil
+}
+
+func TestGetOrLoad_InOrder(t *testing.T) {
+	c := &MockcCache{}
+	s := &MockcStore{}
+
+	// set return value
+	s._Load.Results.R0 = "test_val"
+
+	// execute
+	val, err := GetOrLoad(c, s, "test_key")
+
+	// assert
+	if val != "test_val" {
+		t.Errorf("val should be %q: actual(%v)", "test_val", val)
+	}
+	if err != nil {
+		t.Error("err should be nil")
+	}
+	mockc.InOrder(t, c.Calls().Get(0), s.Calls().Load(0), c.Calls().Set(0))
+}
+
+func TestGetOrLoad_OutOfOrder(t *testing.T) {
+	c := &MockcCache{}
+	s := &MockcStore{}
+
+	// set return value
+	c._Get.Results.R0 = "test_val"
+
+	// execute
+	GetOrLoad(c, s, "test_key")
+
+	// assert
+	r := recorder.New(t)
+	if mockc.InOrder(r, c.Calls().Get(0), s.Calls().Load(0)) {
+		t.Error("InOrder should fail because Store.Load has not been called")
+	}
+	if len(r.Errors) != 1 {
+		t.Errorf("InOrder should report 1 error: actual(%v)", r.Errors)
+	}
+}
+
+func TestGetOrLoad_NestedCalls(t *testing.T) {
+	c := &MockcCache{}
+	s := &MockcStore{}
+
+	// Cache.Get loads the value from Store in its body
+	c._Get.Body = func(key string) (interface{}, error) {
+		return s.Load(key)
+	}
+	s._Load.Results.R0 = "test_val"
+
+	// execute
+	GetOrLoad(c, s, "test_key")
+
+	// assert that the calls are ordered by when they are made, not by when they return
+	mockc.InOrder(t, c.Calls().Get(0), s.Calls().Load(0))
+}
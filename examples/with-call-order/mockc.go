@@ -0,0 +1,17 @@
+//+build mockc
+
+package order
+
+import (
+	"github.com/KimMachineGun/mockc"
+)
+
+func MockcCache() {
+	mockc.Implement(Cache(nil))
+	mockc.WithCallOrder()
+}
+
+func MockcStore() {
+	mockc.Implement(Store(nil))
+	mockc.WithCallOrder()
+}
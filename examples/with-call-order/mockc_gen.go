@@ -0,0 +1,395 @@
+// Code generated by Mockc. DO NOT EDIT.
+// repo: https://github.com/KimMachineGun/mockc
+
+//go:generate mockc
+//go:build !mockc
+// +build !mockc
+
+package order
+
+import (
+	mockc "github.com/KimMachineGun/mockc"
+	"sync"
+)
+
+var _ interface {
+	Cache
+} = &MockcCache{}
+
+type MockcCache struct {
+	// method: Del
+	_Del struct {
+		mu sync.Mutex
+		// basics
+		Called    bool
+		CallCount int
+		// call history
+		History []struct {
+			Params struct {
+				P0 string
+			}
+			Results struct {
+				R0 error
+			}
+			Seq uint64
+		}
+		// params
+		Params struct {
+			P0 string
+		}
+		// results
+		Results struct {
+			R0 error
+		}
+		// if it is not empty, its first element will be consumed instead of the results.
+		ResultsSeq []struct {
+			R0 error
+		}
+		// if it is not nil, it'll be called in the middle of the method.
+		Body func(string) error
+	}
+	// method: Get
+	_Get struct {
+		mu sync.Mutex
+		// basics
+		Called    bool
+		CallCount int
+		// call history
+		History []struct {
+			Params struct {
+				P0 string
+			}
+			Results struct {
+				R0 interface{}
+				R1 error
+			}
+			Seq uint64
+		}
+		// params
+		Params struct {
+			P0 string
+		}
+		// results
+		Results struct {
+			R0 interface{}
+			R1 error
+		}
+		// if it is not empty, its first element will be consumed instead of the results.
+		ResultsSeq []struct {
+			R0 interface{}
+			R1 error
+		}
+		// if it is not nil, it'll be called in the middle of the method.
+		Body func(string) (interface{}, error)
+	}
+	// method: Set
+	_Set struct {
+		mu sync.Mutex
+		// basics
+		Called    bool
+		CallCount int
+		// call history
+		History []struct {
+			Params struct {
+				P0 string
+				P1 interface{}
+			}
+			Results struct {
+				R0 error
+			}
+			Seq uint64
+		}
+		// params
+		Params struct {
+			P0 string
+			P1 interface{}
+		}
+		// results
+		Results struct {
+			R0 error
+		}
+		// if it is not empty, its first element will be consumed instead of the results.
+		ResultsSeq []struct {
+			R0 error
+		}
+		// if it is not nil, it'll be called in the middle of the method.
+		Body func(string, interface{}) error
+	}
+}
+
+func (recv *MockcCache) Del(p0 string) error {
+	// call order
+	seq := mockc.NextSeq()
+	recv._Del.mu.Lock()
+	defer recv._Del.mu.Unlock()
+	// basics
+	recv._Del.Called = true
+	recv._Del.CallCount++
+	// params
+	recv._Del.Params.P0 = p0
+	// default results
+	results := recv._Del.Results
+	// results sequence
+	if recv._Del.Body == nil && len(recv._Del.ResultsSeq) > 0 {
+		results = recv._Del.ResultsSeq[0]
+		recv._Del.ResultsSeq = recv._Del.ResultsSeq[1:]
+	}
+	// body
+	if recv._Del.Body != nil {
+		results.R0 = recv._Del.Body(p0)
+		recv._Del.Results = results
+	}
+	// call history
+	recv._Del.History = append(recv._Del.History, struct {
+		Params struct {
+			P0 string
+		}
+		Results struct {
+			R0 error
+		}
+		Seq uint64
+	}{
+		Params:  recv._Del.Params,
+		Results: results,
+		Seq:     seq,
+	})
+	// results
+	return results.R0
+}
+
+func (recv *MockcCache) Get(p0 string) (interface{}, error) {
+	// call order
+	seq := mockc.NextSeq()
+	recv._Get.mu.Lock()
+	defer recv._Get.mu.Unlock()
+	// basics
+	recv._Get.Called = true
+	recv._Get.CallCount++
+	// params
+	recv._Get.Params.P0 = p0
+	// default results
+	results := recv._Get.Results
+	// results sequence
+	if recv._Get.Body == nil && len(recv._Get.ResultsSeq) > 0 {
+		results = recv._Get.ResultsSeq[0]
+		recv._Get.ResultsSeq = recv._Get.ResultsSeq[1:]
+	}
+	// body
+	if recv._Get.Body != nil {
+		results.R0, results.R1 = recv._Get.Body(p0)
+		recv._Get.Results = results
+	}
+	// call history
+	recv._Get.History = append(recv._Get.History, struct {
+		Params struct {
+			P0 string
+		}
+		Results struct {
+			R0 interface{}
+			R1 error
+		}
+		Seq uint64
+	}{
+		Params:  recv._Get.Params,
+		Results: results,
+		Seq:     seq,
+	})
+	// results
+	return results.R0, results.R1
+}
+
+func (recv *MockcCache) Set(p0 string, p1 interface{}) error {
+	// call order
+	seq := mockc.NextSeq()
+	recv._Set.mu.Lock()
+	defer recv._Set.mu.Unlock()
+	// basics
+	recv._Set.Called = true
+	recv._Set.CallCount++
+	// params
+	recv._Set.Params.P0 = p0
+	recv._Set.Params.P1 = p1
+	// default results
+	results := recv._Set.Results
+	// results sequence
+	if recv._Set.Body == nil && len(recv._Set.ResultsSeq) > 0 {
+		results = recv._Set.ResultsSeq[0]
+		recv._Set.ResultsSeq = recv._Set.ResultsSeq[1:]
+	}
+	// body
+	if recv._Set.Body != nil {
+		results.R0 = recv._Set.Body(p0, p1)
+		recv._Set.Results = results
+	}
+	// call history
+	recv._Set.History = append(recv._Set.History, struct {
+		Params struct {
+			P0 string
+			P1 interface{}
+		}
+		Results struct {
+			R0 error
+		}
+		Seq uint64
+	}{
+		Params:  recv._Set.Params,
+		Results: results,
+		Seq:     seq,
+	})
+	// results
+	return results.R0
+}
+
+type MockcCacheCalls struct {
+	m *MockcCache
+}
+
+func (recv *MockcCache) Calls() MockcCacheCalls {
+	return MockcCacheCalls{m: recv}
+}
+
+func (c MockcCacheCalls) Del(i int) mockc.Call {
+	c.m._Del.mu.Lock()
+	defer c.m._Del.mu.Unlock()
+	call := mockc.Call{
+		Index:  i,
+		Method: "Del",
+		Mock:   "MockcCache",
+	}
+	if i >= 0 && i < len(c.m._Del.History) {
+		call.Seq = c.m._Del.History[i].Seq
+	}
+	return call
+}
+
+func (c MockcCacheCalls) Get(i int) mockc.Call {
+	c.m._Get.mu.Lock()
+	defer c.m._Get.mu.Unlock()
+	call := mockc.Call{
+		Index:  i,
+		Method: "Get",
+		Mock:   "MockcCache",
+	}
+	if i >= 0 && i < len(c.m._Get.History) {
+		call.Seq = c.m._Get.History[i].Seq
+	}
+	return call
+}
+
+func (c MockcCacheCalls) Set(i int) mockc.Call {
+	c.m._Set.mu.Lock()
+	defer c.m._Set.mu.Unlock()
+	call := mockc.Call{
+		Index:  i,
+		Method: "Set",
+		Mock:   "MockcCache",
+	}
+	if i >= 0 && i < len(c.m._Set.History) {
+		call.Seq = c.m._Set.History[i].Seq
+	}
+	return call
+}
+
+var _ interface {
+	Store
+} = &MockcStore{}
+
+type MockcStore struct {
+	// method: Load
+	_Load struct {
+		mu sync.Mutex
+		// basics
+		Called    bool
+		CallCount int
+		// call history
+		History []struct {
+			Params struct {
+				P0 string
+			}
+			Results struct {
+				R0 interface{}
+				R1 error
+			}
+			Seq uint64
+		}
+		// params
+		Params struct {
+			P0 string
+		}
+		// results
+		Results struct {
+			R0 interface{}
+			R1 error
+		}
+		// if it is not empty, its first element will be consumed instead of the results.
+		ResultsSeq []struct {
+			R0 interface{}
+			R1 error
+		}
+		// if it is not nil, it'll be called in the middle of the method.
+		Body func(string) (interface{}, error)
+	}
+}
+
+func (recv *MockcStore) Load(p0 string) (interface{}, error) {
+	// call order
+	seq := mockc.NextSeq()
+	recv._Load.mu.Lock()
+	defer recv._Load.mu.Unlock()
+	// basics
+	recv._Load.Called = true
+	recv._Load.CallCount++
+	// params
+	recv._Load.Params.P0 = p0
+	// default results
+	results := recv._Load.Results
+	// results sequence
+	if recv._Load.Body == nil && len(recv._Load.ResultsSeq) > 0 {
+		results = recv._Load.ResultsSeq[0]
+		recv._Load.ResultsSeq = recv._Load.ResultsSeq[1:]
+	}
+	// body
+	if recv._Load.Body != nil {
+		results.R0, results.R1 = recv._Load.Body(p0)
+		recv._Load.Results = results
+	}
+	// call history
+	recv._Load.History = append(recv._Load.History, struct {
+		Params struct {
+			P0 string
+		}
+		Results struct {
+			R0 interface{}
+			R1 error
+		}
+		Seq uint64
+	}{
+		Params:  recv._Load.Params,
+		Results: results,
+		Seq:     seq,
+	})
+	// results
+	return results.R0, results.R1
+}
+
+type MockcStoreCalls struct {
+	m *MockcStore
+}
+
+func (recv *MockcStore) Calls() MockcStoreCalls {
+	return MockcStoreCalls{m: recv}
+}
+
+func (c MockcStoreCalls) Load(i int) mockc.Call {
+	c.m._Load.mu.Lock()
+	defer c.m._Load.mu.Unlock()
+	call := mockc.Call{
+		Index:  i,
+		Method: "Load",
+		Mock:   "MockcStore",
+	}
+	if i >= 0 && i < len(c.m._Load.History) {
+		call.Seq = c.m._Load.History[i].Seq
+	}
+	return call
+}
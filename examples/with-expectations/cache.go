@@ -0,0 +1,12 @@
+package expectations
+
+import (
+	"context"
+)
+
+type Cache interface {
+	Get(key string) (val interface{}, err error)
+	Set(key string, val interface{}) (err error)
+	Del(key string) (err error)
+	Range(ctx context.Context, fn func(key string, val interface{}) bool) (err error)
+}
@@ -0,0 +1,124 @@
+package expectations
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/KimMachineGun/mockc/examples/internal/recorder"
+)
+
+func HasKey(c Cache, key string) (bool, error) {
+	val, err := c.Get(key)
+	if err != nil {
+		return false, err
+	}
+
+	return val != nil, nil
+}
+
+func TestHasKey_WithExpectations(t *testing.T) {
+	m := &MockcCache{}
+
+	// declare expected calls
+	m.ExpectGet().WithKey("test_key").Return(struct{}{}, nil).Times(1)
+	m.ExpectGet().WithKeyFunc(func(key string) bool {
+		return strings.HasPrefix(key, "error:")
+	}).Return(nil, errors.New("error"))
+
+	// execute
+	result, err := HasKey(m, "test_key")
+
+	// assert
+	if !result {
+		t.Error("result should be true")
+	}
+	if err != nil {
+		t.Error("err should be nil")
+	}
+
+	// execute
+	result, err = HasKey(m, "error:test_key")
+
+	// assert
+	if result {
+		t.Error("result should be false")
+	}
+	if err == nil {
+		t.Error("err should not be nil")
+	}
+
+	m.AssertExpectations(t)
+}
+
+func TestHasKey_WithUnmetExpectations(t *testing.T) {
+	m := &MockcCache{}
+
+	m.ExpectGet().WithKey("test_key").Times(2)
+	m.ExpectDel()
+
+	// execute
+	HasKey(m, "test_key")
+	HasKey(m, "unknown_key")
+
+	// assert
+	rec := recorder.New(t)
+	m.AssertExpectations(rec)
+
+	expected := []string{
+		`MockcCache.Del(_): expected at least one call, but got none`,
+		`MockcCache.Get("test_key"): expected 2 call(s), but got 1`,
+		`MockcCache.Get("unknown_key"): unexpected call`,
+	}
+	if strings.Join(rec.Errors, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("unexpected errors: %q", rec.Errors)
+	}
+}
+
+func TestRange_WithFuncParam(t *testing.T) {
+	m := &MockcCache{}
+
+	// the function params can be matched only by the With<Param>Func methods
+	m.ExpectRange().WithCtx(context.Background()).WithFnFunc(func(fn func(key string, val interface{}) bool) bool {
+		return fn != nil
+	})
+
+	// execute
+	m.Range(context.Background(), nil)
+
+	// assert
+	rec := recorder.New(t)
+	m.AssertExpectations(rec)
+
+	expected := []string{
+		`MockcCache.Range(ctx, <func>): expected at least one call, but got none`,
+		`MockcCache.Range(ctx, nil): unexpected call`,
+	}
+	if strings.Join(rec.Errors, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("unexpected errors: %q", rec.Errors)
+	}
+}
+
+func TestDel_NeverCalled(t *testing.T) {
+	m := &MockcCache{}
+
+	// declare the call that should never be made
+	m.ExpectDel().WithKey("protected_key").Times(0)
+	m.ExpectDel()
+
+	// execute
+	m.Del("test_key")
+	m.Del("protected_key")
+
+	// assert
+	rec := recorder.New(t)
+	m.AssertExpectations(rec)
+
+	expected := []string{
+		`MockcCache.Del("protected_key"): expected 0 call(s), but got 1`,
+	}
+	if strings.Join(rec.Errors, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("unexpected errors: %q", rec.Errors)
+	}
+}
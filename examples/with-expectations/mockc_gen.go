@@ -0,0 +1,751 @@
+// Code generated by Mockc. DO NOT EDIT.
+// repo: https://github.com/KimMachineGun/mockc
+
+//go:generate mockc
+//go:build !mockc
+// +build !mockc
+
+package expectations
+
+import (
+	"context"
+	"fmt"
+	mockc "github.com/KimMachineGun/mockc"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+)
+
+var _ interface {
+	Cache
+} = &MockcCache{}
+
+type MockcCache struct {
+	// method: Del
+	_Del struct {
+		mu sync.Mutex
+		// basics
+		Called    bool
+		CallCount int
+		// call history
+		History []struct {
+			Params struct {
+				Key string
+			}
+			Results struct {
+				Err error
+			}
+		}
+		// params
+		Params struct {
+			Key string
+		}
+		// results
+		Results struct {
+			Err error
+		}
+		// if it is not empty, its first element will be consumed instead of the results.
+		ResultsSeq []struct {
+			Err error
+		}
+		// expectations
+		expectations    []*MockcCacheDelExpectation
+		unexpectedCalls []string
+		// if it is not nil, it'll be called in the middle of the method.
+		Body func(string) error
+	}
+	// method: Get
+	_Get struct {
+		mu sync.Mutex
+		// basics
+		Called    bool
+		CallCount int
+		// call history
+		History []struct {
+			Params struct {
+				Key string
+			}
+			Results struct {
+				Val interface{}
+				Err error
+			}
+		}
+		// params
+		Params struct {
+			Key string
+		}
+		// results
+		Results struct {
+			Val interface{}
+			Err error
+		}
+		// if it is not empty, its first element will be consumed instead of the results.
+		ResultsSeq []struct {
+			Val interface{}
+			Err error
+		}
+		// expectations
+		expectations    []*MockcCacheGetExpectation
+		unexpectedCalls []string
+		// if it is not nil, it'll be called in the middle of the method.
+		Body func(string) (interface{}, error)
+	}
+	// method: Range
+	_Range struct {
+		mu sync.Mutex
+		// basics
+		Called    bool
+		CallCount int
+		// call history
+		History []struct {
+			Params struct {
+				Ctx context.Context
+				Fn  func(string, interface{}) bool
+			}
+			Results struct {
+				Err error
+			}
+		}
+		// params
+		Params struct {
+			Ctx context.Context
+			Fn  func(string, interface{}) bool
+		}
+		// results
+		Results struct {
+			Err error
+		}
+		// if it is not empty, its first element will be consumed instead of the results.
+		ResultsSeq []struct {
+			Err error
+		}
+		// expectations
+		expectations    []*MockcCacheRangeExpectation
+		unexpectedCalls []string
+		// if it is not nil, it'll be called in the middle of the method.
+		Body func(context.Context, func(string, interface{}) bool) error
+	}
+	// method: Set
+	_Set struct {
+		mu sync.Mutex
+		// basics
+		Called    bool
+		CallCount int
+		// call history
+		History []struct {
+			Params struct {
+				Key string
+				Val interface{}
+			}
+			Results struct {
+				Err error
+			}
+		}
+		// params
+		Params struct {
+			Key string
+			Val interface{}
+		}
+		// results
+		Results struct {
+			Err error
+		}
+		// if it is not empty, its first element will be consumed instead of the results.
+		ResultsSeq []struct {
+			Err error
+		}
+		// expectations
+		expectations    []*MockcCacheSetExpectation
+		unexpectedCalls []string
+		// if it is not nil, it'll be called in the middle of the method.
+		Body func(string, interface{}) error
+	}
+}
+
+func (recv *MockcCache) Del(key string) error {
+	recv._Del.mu.Lock()
+	defer recv._Del.mu.Unlock()
+	// basics
+	recv._Del.Called = true
+	recv._Del.CallCount++
+	// params
+	recv._Del.Params.Key = key
+	// default results
+	results := recv._Del.Results
+	supplied := false
+	// expectations
+	matched := false
+	for _, expectation := range recv._Del.expectations {
+		if !expectation.match(key) {
+			continue
+		}
+		matched = true
+		expectation.calls++
+		if expectation.returns {
+			results = expectation.results
+			supplied = true
+		}
+		break
+	}
+	if !matched {
+		recv._Del.unexpectedCalls = append(recv._Del.unexpectedCalls, mockc.FormatCall("Del", key))
+	}
+	// results sequence
+	if !supplied && recv._Del.Body == nil && len(recv._Del.ResultsSeq) > 0 {
+		results = recv._Del.ResultsSeq[0]
+		recv._Del.ResultsSeq = recv._Del.ResultsSeq[1:]
+	}
+	// body
+	if recv._Del.Body != nil {
+		results.Err = recv._Del.Body(key)
+		recv._Del.Results = results
+	}
+	// call history
+	recv._Del.History = append(recv._Del.History, struct {
+		Params struct {
+			Key string
+		}
+		Results struct {
+			Err error
+		}
+	}{
+		Params:  recv._Del.Params,
+		Results: results,
+	})
+	// results
+	return results.Err
+}
+
+func (recv *MockcCache) Get(key string) (interface{}, error) {
+	recv._Get.mu.Lock()
+	defer recv._Get.mu.Unlock()
+	// basics
+	recv._Get.Called = true
+	recv._Get.CallCount++
+	// params
+	recv._Get.Params.Key = key
+	// default results
+	results := recv._Get.Results
+	supplied := false
+	// expectations
+	matched := false
+	for _, expectation := range recv._Get.expectations {
+		if !expectation.match(key) {
+			continue
+		}
+		matched = true
+		expectation.calls++
+		if expectation.returns {
+			results = expectation.results
+			supplied = true
+		}
+		break
+	}
+	if !matched {
+		recv._Get.unexpectedCalls = append(recv._Get.unexpectedCalls, mockc.FormatCall("Get", key))
+	}
+	// results sequence
+	if !supplied && recv._Get.Body == nil && len(recv._Get.ResultsSeq) > 0 {
+		results = recv._Get.ResultsSeq[0]
+		recv._Get.ResultsSeq = recv._Get.ResultsSeq[1:]
+	}
+	// body
+	if recv._Get.Body != nil {
+		results.Val, results.Err = recv._Get.Body(key)
+		recv._Get.Results = results
+	}
+	// call history
+	recv._Get.History = append(recv._Get.History, struct {
+		Params struct {
+			Key string
+		}
+		Results struct {
+			Val interface{}
+			Err error
+		}
+	}{
+		Params:  recv._Get.Params,
+		Results: results,
+	})
+	// results
+	return results.Val, results.Err
+}
+
+func (recv *MockcCache) Range(ctx context.Context, fn func(string, interface{}) bool) error {
+	recv._Range.mu.Lock()
+	defer recv._Range.mu.Unlock()
+	// basics
+	recv._Range.Called = true
+	recv._Range.CallCount++
+	// params
+	recv._Range.Params.Ctx = ctx
+	recv._Range.Params.Fn = fn
+	// default results
+	results := recv._Range.Results
+	supplied := false
+	// expectations
+	matched := false
+	for _, expectation := range recv._Range.expectations {
+		if !expectation.match(ctx, fn) {
+			continue
+		}
+		matched = true
+		expectation.calls++
+		if expectation.returns {
+			results = expectation.results
+			supplied = true
+		}
+		break
+	}
+	if !matched {
+		recv._Range.unexpectedCalls = append(recv._Range.unexpectedCalls, mockc.FormatCall("Range", ctx, fn))
+	}
+	// results sequence
+	if !supplied && recv._Range.Body == nil && len(recv._Range.ResultsSeq) > 0 {
+		results = recv._Range.ResultsSeq[0]
+		recv._Range.ResultsSeq = recv._Range.ResultsSeq[1:]
+	}
+	// body
+	if recv._Range.Body != nil {
+		results.Err = recv._Range.Body(ctx, fn)
+		recv._Range.Results = results
+	}
+	// call history
+	recv._Range.History = append(recv._Range.History, struct {
+		Params struct {
+			Ctx context.Context
+			Fn  func(string, interface{}) bool
+		}
+		Results struct {
+			Err error
+		}
+	}{
+		Params:  recv._Range.Params,
+		Results: results,
+	})
+	// results
+	return results.Err
+}
+
+func (recv *MockcCache) Set(key string, val interface{}) error {
+	recv._Set.mu.Lock()
+	defer recv._Set.mu.Unlock()
+	// basics
+	recv._Set.Called = true
+	recv._Set.CallCount++
+	// params
+	recv._Set.Params.Key = key
+	recv._Set.Params.Val = val
+	// default results
+	results := recv._Set.Results
+	supplied := false
+	// expectations
+	matched := false
+	for _, expectation := range recv._Set.expectations {
+		if !expectation.match(key, val) {
+			continue
+		}
+		matched = true
+		expectation.calls++
+		if expectation.returns {
+			results = expectation.results
+			supplied = true
+		}
+		break
+	}
+	if !matched {
+		recv._Set.unexpectedCalls = append(recv._Set.unexpectedCalls, mockc.FormatCall("Set", key, val))
+	}
+	// results sequence
+	if !supplied && recv._Set.Body == nil && len(recv._Set.ResultsSeq) > 0 {
+		results = recv._Set.ResultsSeq[0]
+		recv._Set.ResultsSeq = recv._Set.ResultsSeq[1:]
+	}
+	// body
+	if recv._Set.Body != nil {
+		results.Err = recv._Set.Body(key, val)
+		recv._Set.Results = results
+	}
+	// call history
+	recv._Set.History = append(recv._Set.History, struct {
+		Params struct {
+			Key string
+			Val interface{}
+		}
+		Results struct {
+			Err error
+		}
+	}{
+		Params:  recv._Set.Params,
+		Results: results,
+	})
+	// results
+	return results.Err
+}
+
+type MockcCacheDelExpectation struct {
+	matchers struct {
+		Key func(string) bool
+	}
+	descriptions [1]string
+	returns      bool
+	results      struct {
+		Err error
+	}
+	// it is -1 if the method is expected to be called at least once.
+	times int
+	calls int
+}
+
+func (recv *MockcCache) ExpectDel() *MockcCacheDelExpectation {
+	recv._Del.mu.Lock()
+	defer recv._Del.mu.Unlock()
+	e := &MockcCacheDelExpectation{times: -1}
+	recv._Del.expectations = append(recv._Del.expectations, e)
+	return e
+}
+
+func (e *MockcCacheDelExpectation) WithKey(v string) *MockcCacheDelExpectation {
+	e.matchers.Key = func(actual string) bool {
+		return reflect.DeepEqual(actual, v)
+	}
+	e.descriptions[0] = mockc.FormatValue(v)
+	return e
+}
+
+func (e *MockcCacheDelExpectation) WithKeyFunc(match func(string) bool) *MockcCacheDelExpectation {
+	e.matchers.Key = match
+	e.descriptions[0] = "<func>"
+	return e
+}
+
+func (e *MockcCacheDelExpectation) Return(r0 error) *MockcCacheDelExpectation {
+	e.returns = true
+	e.results.Err = r0
+	return e
+}
+
+func (e *MockcCacheDelExpectation) Times(n int) *MockcCacheDelExpectation {
+	if n < 0 {
+		panic(fmt.Sprintf("MockcCache.%s: Times(%d): the number of calls must not be negative", e.describe(), n))
+	}
+	e.times = n
+	return e
+}
+
+func (e *MockcCacheDelExpectation) match(p0 string) bool {
+	if e.times > 0 && e.calls >= e.times {
+		return false
+	}
+	if e.matchers.Key != nil && !e.matchers.Key(p0) {
+		return false
+	}
+	return true
+}
+
+func (e *MockcCacheDelExpectation) describe() string {
+	args := make([]string, len(e.descriptions))
+	for i, description := range e.descriptions {
+		if description == "" {
+			description = "_"
+		}
+		args[i] = description
+	}
+	return "Del(" + strings.Join(args, ", ") + ")"
+}
+
+type MockcCacheGetExpectation struct {
+	matchers struct {
+		Key func(string) bool
+	}
+	descriptions [1]string
+	returns      bool
+	results      struct {
+		Val interface{}
+		Err error
+	}
+	// it is -1 if the method is expected to be called at least once.
+	times int
+	calls int
+}
+
+func (recv *MockcCache) ExpectGet() *MockcCacheGetExpectation {
+	recv._Get.mu.Lock()
+	defer recv._Get.mu.Unlock()
+	e := &MockcCacheGetExpectation{times: -1}
+	recv._Get.expectations = append(recv._Get.expectations, e)
+	return e
+}
+
+func (e *MockcCacheGetExpectation) WithKey(v string) *MockcCacheGetExpectation {
+	e.matchers.Key = func(actual string) bool {
+		return reflect.DeepEqual(actual, v)
+	}
+	e.descriptions[0] = mockc.FormatValue(v)
+	return e
+}
+
+func (e *MockcCacheGetExpectation) WithKeyFunc(match func(string) bool) *MockcCacheGetExpectation {
+	e.matchers.Key = match
+	e.descriptions[0] = "<func>"
+	return e
+}
+
+func (e *MockcCacheGetExpectation) Return(r0 interface{}, r1 error) *MockcCacheGetExpectation {
+	e.returns = true
+	e.results.Val = r0
+	e.results.Err = r1
+	return e
+}
+
+func (e *MockcCacheGetExpectation) Times(n int) *MockcCacheGetExpectation {
+	if n < 0 {
+		panic(fmt.Sprintf("MockcCache.%s: Times(%d): the number of calls must not be negative", e.describe(), n))
+	}
+	e.times = n
+	return e
+}
+
+func (e *MockcCacheGetExpectation) match(p0 string) bool {
+	if e.times > 0 && e.calls >= e.times {
+		return false
+	}
+	if e.matchers.Key != nil && !e.matchers.Key(p0) {
+		return false
+	}
+	return true
+}
+
+func (e *MockcCacheGetExpectation) describe() string {
+	args := make([]string, len(e.descriptions))
+	for i, description := range e.descriptions {
+		if description == "" {
+			description = "_"
+		}
+		args[i] = description
+	}
+	return "Get(" + strings.Join(args, ", ") + ")"
+}
+
+type MockcCacheRangeExpectation struct {
+	matchers struct {
+		Ctx func(context.Context) bool
+		Fn  func(func(string, interface{}) bool) bool
+	}
+	descriptions [2]string
+	returns      bool
+	results      struct {
+		Err error
+	}
+	// it is -1 if the method is expected to be called at least once.
+	times int
+	calls int
+}
+
+func (recv *MockcCache) ExpectRange() *MockcCacheRangeExpectation {
+	recv._Range.mu.Lock()
+	defer recv._Range.mu.Unlock()
+	e := &MockcCacheRangeExpectation{times: -1}
+	recv._Range.expectations = append(recv._Range.expectations, e)
+	return e
+}
+
+func (e *MockcCacheRangeExpectation) WithCtx(v context.Context) *MockcCacheRangeExpectation {
+	e.matchers.Ctx = func(actual context.Context) bool {
+		return reflect.DeepEqual(actual, v)
+	}
+	e.descriptions[0] = mockc.FormatValue(v)
+	return e
+}
+
+func (e *MockcCacheRangeExpectation) WithCtxFunc(match func(context.Context) bool) *MockcCacheRangeExpectation {
+	e.matchers.Ctx = match
+	e.descriptions[0] = "<func>"
+	return e
+}
+
+func (e *MockcCacheRangeExpectation) WithFnFunc(match func(func(string, interface{}) bool) bool) *MockcCacheRangeExpectation {
+	e.matchers.Fn = match
+	e.descriptions[1] = "<func>"
+	return e
+}
+
+func (e *MockcCacheRangeExpectation) Return(r0 error) *MockcCacheRangeExpectation {
+	e.returns = true
+	e.results.Err = r0
+	return e
+}
+
+func (e *MockcCacheRangeExpectation) Times(n int) *MockcCacheRangeExpectation {
+	if n < 0 {
+		panic(fmt.Sprintf("MockcCache.%s: Times(%d): the number of calls must not be negative", e.describe(), n))
+	}
+	e.times = n
+	return e
+}
+
+func (e *MockcCacheRangeExpectation) match(p0 context.Context, p1 func(string, interface{}) bool) bool {
+	if e.times > 0 && e.calls >= e.times {
+		return false
+	}
+	if e.matchers.Ctx != nil && !e.matchers.Ctx(p0) {
+		return false
+	}
+	if e.matchers.Fn != nil && !e.matchers.Fn(p1) {
+		return false
+	}
+	return true
+}
+
+func (e *MockcCacheRangeExpectation) describe() string {
+	args := make([]string, len(e.descriptions))
+	for i, description := range e.descriptions {
+		if description == "" {
+			description = "_"
+		}
+		args[i] = description
+	}
+	return "Range(" + strings.Join(args, ", ") + ")"
+}
+
+type MockcCacheSetExpectation struct {
+	matchers struct {
+		Key func(string) bool
+		Val func(interface{}) bool
+	}
+	descriptions [2]string
+	returns      bool
+	results      struct {
+		Err error
+	}
+	// it is -1 if the method is expected to be called at least once.
+	times int
+	calls int
+}
+
+func (recv *MockcCache) ExpectSet() *MockcCacheSetExpectation {
+	recv._Set.mu.Lock()
+	defer recv._Set.mu.Unlock()
+	e := &MockcCacheSetExpectation{times: -1}
+	recv._Set.expectations = append(recv._Set.expectations, e)
+	return e
+}
+
+func (e *MockcCacheSetExpectation) WithKey(v string) *MockcCacheSetExpectation {
+	e.matchers.Key = func(actual string) bool {
+		return reflect.DeepEqual(actual, v)
+	}
+	e.descriptions[0] = mockc.FormatValue(v)
+	return e
+}
+
+func (e *MockcCacheSetExpectation) WithKeyFunc(match func(string) bool) *MockcCacheSetExpectation {
+	e.matchers.Key = match
+	e.descriptions[0] = "<func>"
+	return e
+}
+
+func (e *MockcCacheSetExpectation) WithVal(v interface{}) *MockcCacheSetExpectation {
+	e.matchers.Val = func(actual interface{}) bool {
+		return reflect.DeepEqual(actual, v)
+	}
+	e.descriptions[1] = mockc.FormatValue(v)
+	return e
+}
+
+func (e *MockcCacheSetExpectation) WithValFunc(match func(interface{}) bool) *MockcCacheSetExpectation {
+	e.matchers.Val = match
+	e.descriptions[1] = "<func>"
+	return e
+}
+
+func (e *MockcCacheSetExpectation) Return(r0 error) *MockcCacheSetExpectation {
+	e.returns = true
+	e.results.Err = r0
+	return e
+}
+
+func (e *MockcCacheSetExpectation) Times(n int) *MockcCacheSetExpectation {
+	if n < 0 {
+		panic(fmt.Sprintf("MockcCache.%s: Times(%d): the number of calls must not be negative", e.describe(), n))
+	}
+	e.times = n
+	return e
+}
+
+func (e *MockcCacheSetExpectation) match(p0 string, p1 interface{}) bool {
+	if e.times > 0 && e.calls >= e.times {
+		return false
+	}
+	if e.matchers.Key != nil && !e.matchers.Key(p0) {
+		return false
+	}
+	if e.matchers.Val != nil && !e.matchers.Val(p1) {
+		return false
+	}
+	return true
+}
+
+func (e *MockcCacheSetExpectation) describe() string {
+	args := make([]string, len(e.descriptions))
+	for i, description := range e.descriptions {
+		if description == "" {
+			description = "_"
+		}
+		args[i] = description
+	}
+	return "Set(" + strings.Join(args, ", ") + ")"
+}
+
+func (recv *MockcCache) AssertExpectations(t testing.TB) {
+	t.Helper()
+	recv._Del.mu.Lock()
+	for _, e := range recv._Del.expectations {
+		if e.times >= 0 && e.calls != e.times {
+			t.Errorf("MockcCache.%s: expected %d call(s), but got %d", e.describe(), e.times, e.calls)
+		} else if e.times < 0 && e.calls == 0 {
+			t.Errorf("MockcCache.%s: expected at least one call, but got none", e.describe())
+		}
+	}
+	for _, call := range recv._Del.unexpectedCalls {
+		t.Errorf("MockcCache.%s: unexpected call", call)
+	}
+	recv._Del.mu.Unlock()
+	recv._Get.mu.Lock()
+	for _, e := range recv._Get.expectations {
+		if e.times >= 0 && e.calls != e.times {
+			t.Errorf("MockcCache.%s: expected %d call(s), but got %d", e.describe(), e.times, e.calls)
+		} else if e.times < 0 && e.calls == 0 {
+			t.Errorf("MockcCache.%s: expected at least one call, but got none", e.describe())
+		}
+	}
+	for _, call := range recv._Get.unexpectedCalls {
+		t.Errorf("MockcCache.%s: unexpected call", call)
+	}
+	recv._Get.mu.Unlock()
+	recv._Range.mu.Lock()
+	for _, e := range recv._Range.expectations {
+		if e.times >= 0 && e.calls != e.times {
+			t.Errorf("MockcCache.%s: expected %d call(s), but got %d", e.describe(), e.times, e.calls)
+		} else if e.times < 0 && e.calls == 0 {
+			t.Errorf("MockcCache.%s: expected at least one call, but got none", e.describe())
+		}
+	}
+	for _, call := range recv._Range.unexpectedCalls {
+		t.Errorf("MockcCache.%s: unexpected call", call)
+	}
+	recv._Range.mu.Unlock()
+	recv._Set.mu.Lock()
+	for _, e := range recv._Set.expectations {
+		if e.times >= 0 && e.calls != e.times {
+			t.Errorf("MockcCache.%s: expected %d call(s), but got %d", e.describe(), e.times, e.calls)
+		} else if e.times < 0 && e.calls == 0 {
+			t.Errorf("MockcCache.%s: expected at least one call, but got none", e.describe())
+		}
+	}
+	for _, call := range recv._Set.unexpectedCalls {
+		t.Errorf("MockcCache.%s: unexpected call", call)
+	}
+	recv._Set.mu.Unlock()
+}
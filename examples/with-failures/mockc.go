@@ -0,0 +1,12 @@
+//+build mockc
+
+package failures
+
+import (
+	"github.com/KimMachineGun/mockc"
+)
+
+func MockcStore() {
+	mockc.Implement(Store(nil))
+	mockc.WithFailures()
+}
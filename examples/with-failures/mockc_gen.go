@@ -0,0 +1,206 @@
+// Code generated by Mockc. DO NOT EDIT.
+// repo: https://github.com/KimMachineGun/mockc
+
+//go:generate mockc
+//go:build !mockc
+// +build !mockc
+
+package failures
+
+import "sync"
+
+var _ interface {
+	Store
+} = &MockcStore{}
+
+type MockcStore struct {
+	// method: Load
+	_Load struct {
+		mu sync.Mutex
+		// basics
+		Called    bool
+		CallCount int
+		// call history
+		History []struct {
+			Params struct {
+				P0 string
+			}
+			Results struct {
+				R0 []byte
+				R1 error
+			}
+		}
+		// params
+		Params struct {
+			P0 string
+		}
+		// results
+		Results struct {
+			R0 []byte
+			R1 error
+		}
+		// if it is not empty, its first element will be consumed instead of the results.
+		ResultsSeq []struct {
+			R0 []byte
+			R1 error
+		}
+		// if it is not nil, it'll be the last result after the failAfter calls.
+		failErr   error
+		failAfter int
+		// if it is not nil, it'll be called in the middle of the method.
+		Body func(string) ([]byte, error)
+	}
+	// method: Save
+	_Save struct {
+		mu sync.Mutex
+		// basics
+		Called    bool
+		CallCount int
+		// call history
+		History []struct {
+			Params struct {
+				P0 string
+				P1 []byte
+			}
+			Results struct {
+				R0 error
+			}
+		}
+		// params
+		Params struct {
+			P0 string
+			P1 []byte
+		}
+		// results
+		Results struct {
+			R0 error
+		}
+		// if it is not empty, its first element will be consumed instead of the results.
+		ResultsSeq []struct {
+			R0 error
+		}
+		// if it is not nil, it'll be the last result after the failAfter calls.
+		failErr   error
+		failAfter int
+		// if it is not nil, it'll be called in the middle of the method.
+		Body func(string, []byte) error
+	}
+}
+
+func (recv *MockcStore) Load(p0 string) ([]byte, error) {
+	recv._Load.mu.Lock()
+	defer recv._Load.mu.Unlock()
+	// basics
+	recv._Load.Called = true
+	recv._Load.CallCount++
+	// params
+	recv._Load.Params.P0 = p0
+	// default results
+	results := recv._Load.Results
+	// results sequence
+	if recv._Load.Body == nil && len(recv._Load.ResultsSeq) > 0 {
+		results = recv._Load.ResultsSeq[0]
+		recv._Load.ResultsSeq = recv._Load.ResultsSeq[1:]
+	}
+	// body
+	if recv._Load.Body != nil {
+		results.R0, results.R1 = recv._Load.Body(p0)
+		recv._Load.Results = results
+	}
+	// failure
+	if recv._Load.failErr != nil && recv._Load.CallCount > recv._Load.failAfter {
+		results.R1 = recv._Load.failErr
+	}
+	// call history
+	recv._Load.History = append(recv._Load.History, struct {
+		Params struct {
+			P0 string
+		}
+		Results struct {
+			R0 []byte
+			R1 error
+		}
+	}{
+		Params:  recv._Load.Params,
+		Results: results,
+	})
+	// results
+	return results.R0, results.R1
+}
+
+func (recv *MockcStore) Save(p0 string, p1 []byte) error {
+	recv._Save.mu.Lock()
+	defer recv._Save.mu.Unlock()
+	// basics
+	recv._Save.Called = true
+	recv._Save.CallCount++
+	// params
+	recv._Save.Params.P0 = p0
+	recv._Save.Params.P1 = p1
+	// default results
+	results := recv._Save.Results
+	// results sequence
+	if recv._Save.Body == nil && len(recv._Save.ResultsSeq) > 0 {
+		results = recv._Save.ResultsSeq[0]
+		recv._Save.ResultsSeq = recv._Save.ResultsSeq[1:]
+	}
+	// body
+	if recv._Save.Body != nil {
+		results.R0 = recv._Save.Body(p0, p1)
+		recv._Save.Results = results
+	}
+	// failure
+	if recv._Save.failErr != nil && recv._Save.CallCount > recv._Save.failAfter {
+		results.R0 = recv._Save.failErr
+	}
+	// call history
+	recv._Save.History = append(recv._Save.History, struct {
+		Params struct {
+			P0 string
+			P1 []byte
+		}
+		Results struct {
+			R0 error
+		}
+	}{
+		Params:  recv._Save.Params,
+		Results: results,
+	})
+	// results
+	return results.R0
+}
+
+func (recv *MockcStore) FailAll(err error) {
+	recv.LoadFailsAfter(0, err)
+	recv.SaveFailsAfter(0, err)
+}
+
+func (recv *MockcStore) LoadPanicsWith(v interface{}) {
+	recv._Load.mu.Lock()
+	defer recv._Load.mu.Unlock()
+	recv._Load.Body = func(string) ([]byte, error) {
+		panic(v)
+	}
+}
+
+func (recv *MockcStore) LoadFailsAfter(n int, err error) {
+	recv._Load.mu.Lock()
+	defer recv._Load.mu.Unlock()
+	recv._Load.failErr = err
+	recv._Load.failAfter = recv._Load.CallCount + n
+}
+
+func (recv *MockcStore) SavePanicsWith(v interface{}) {
+	recv._Save.mu.Lock()
+	defer recv._Save.mu.Unlock()
+	recv._Save.Body = func(string, []byte) error {
+		panic(v)
+	}
+}
+
+func (recv *MockcStore) SaveFailsAfter(n int, err error) {
+	recv._Save.mu.Lock()
+	defer recv._Save.mu.Unlock()
+	recv._Save.failErr = err
+	recv._Save.failAfter = recv._Save.CallCount + n
+}
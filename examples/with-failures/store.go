@@ -0,0 +1,39 @@
+package failures
+
+import (
+	"fmt"
+)
+
+type Store interface {
+	Load(key string) (val []byte, err error)
+	Save(key string, val []byte) (err error)
+}
+
+// Copy copies the values of the keys from src to dst, and stops at the first error.
+func Copy(dst Store, src Store, keys ...string) (n int, err error) {
+	for _, key := range keys {
+		val, err := src.Load(key)
+		if err != nil {
+			return n, fmt.Errorf("cannot load %s: %w", key, err)
+		}
+
+		err = dst.Save(key, val)
+		if err != nil {
+			return n, fmt.Errorf("cannot save %s: %w", key, err)
+		}
+		n++
+	}
+
+	return n, nil
+}
+
+// SafeCopy is Copy that recovers from the panics of the stores.
+func SafeCopy(dst Store, src Store, keys ...string) (n int, err error) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			err = fmt.Errorf("panic: %v", rec)
+		}
+	}()
+
+	return Copy(dst, src, keys...)
+}
@@ -0,0 +1,65 @@
+package failures
+
+import (
+	"errors"
+	"testing"
+)
+
+var errUnavailable = errors.New("unavailable")
+
+func TestCopy_FailsAfter(t *testing.T) {
+	src, dst := &MockcStore{}, &MockcStore{}
+
+	// fail on the third save
+	dst.SaveFailsAfter(2, errUnavailable)
+
+	// execute
+	n, err := Copy(dst, src, "a", "b", "c", "d")
+
+	// assert
+	if !errors.Is(err, errUnavailable) {
+		t.Errorf("err should be %v: actual(%v)", errUnavailable, err)
+	}
+	if n != 2 {
+		t.Errorf("n should be 2: actual(%d)", n)
+	}
+	if dst._Save.CallCount != 3 {
+		t.Errorf("Store.Save should be called 3 times: actual(%d)", dst._Save.CallCount)
+	}
+}
+
+func TestCopy_FailAll(t *testing.T) {
+	src, dst := &MockcStore{}, &MockcStore{}
+
+	// fail on every call
+	src.FailAll(errUnavailable)
+
+	// execute
+	n, err := Copy(dst, src, "a", "b")
+
+	// assert
+	if !errors.Is(err, errUnavailable) {
+		t.Errorf("err should be %v: actual(%v)", errUnavailable, err)
+	}
+	if n != 0 {
+		t.Errorf("n should be 0: actual(%d)", n)
+	}
+	if dst._Save.Called {
+		t.Error("Store.Save should not be called")
+	}
+}
+
+func TestSafeCopy_PanicsWith(t *testing.T) {
+	src, dst := &MockcStore{}, &MockcStore{}
+
+	// panic on the load
+	src.LoadPanicsWith("boom")
+
+	// execute
+	_, err := SafeCopy(dst, src, "a")
+
+	// assert
+	if err == nil || err.Error() != "panic: boom" {
+		t.Errorf("err should be the recovered panic: actual(%v)", err)
+	}
+}
@@ -0,0 +1,14 @@
+//+build mockc
+
+package latency
+
+import (
+	"github.com/KimMachineGun/mockc"
+)
+
+func MockcUserRepo() {
+	mockc.Implement(UserRepo(nil))
+	mockc.WithLatency()
+	mockc.WithAccessors()
+	mockc.WithWaiters()
+}
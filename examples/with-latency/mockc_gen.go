@@ -0,0 +1,223 @@
+// Code generated by Mockc. DO NOT EDIT.
+// repo: https://github.com/KimMachineGun/mockc
+
+//go:generate mockc
+//go:build !mockc
+// +build !mockc
+
+package latency
+
+import (
+	"context"
+	mockc "github.com/KimMachineGun/mockc"
+	"sync"
+	"time"
+)
+
+var _ interface {
+	UserRepo
+} = &MockcUserRepo{}
+
+type MockcUserRepo struct {
+	// method: FindName
+	_FindName struct {
+		mu sync.Mutex
+		// basics
+		Called    bool
+		CallCount int
+		// it'll be closed on the next call.
+		notify chan struct{}
+		// call history
+		History []struct {
+			Params struct {
+				P0 context.Context
+				P1 string
+			}
+			Results struct {
+				R0 string
+				R1 error
+			}
+		}
+		// params
+		Params struct {
+			P0 context.Context
+			P1 string
+		}
+		// results
+		Results struct {
+			R0 string
+			R1 error
+		}
+		// if it is not empty, its first element will be consumed instead of the results.
+		ResultsSeq []struct {
+			R0 string
+			R1 error
+		}
+		// the method sleeps for the delay, and stops sleeping when the context is done if it respects the context.
+		Delay          time.Duration
+		RespectContext bool
+		// if it is not nil, it'll be called in the middle of the method.
+		Body func(context.Context, string) (string, error)
+	}
+}
+
+func (recv *MockcUserRepo) FindName(p0 context.Context, p1 string) (string, error) {
+	// latency
+	recv._FindName.mu.Lock()
+	delay, respectContext := recv._FindName.Delay, recv._FindName.RespectContext
+	recv._FindName.mu.Unlock()
+	ctxErr := mockc.Sleep(p0, delay, respectContext)
+	recv._FindName.mu.Lock()
+	defer recv._FindName.mu.Unlock()
+	// basics
+	recv._FindName.Called = true
+	recv._FindName.CallCount++
+	if recv._FindName.notify != nil {
+		close(recv._FindName.notify)
+		recv._FindName.notify = nil
+	}
+	// params
+	recv._FindName.Params.P0 = p0
+	recv._FindName.Params.P1 = p1
+	// default results
+	results := recv._FindName.Results
+	// results sequence
+	if recv._FindName.Body == nil && len(recv._FindName.ResultsSeq) > 0 {
+		results = recv._FindName.ResultsSeq[0]
+		recv._FindName.ResultsSeq = recv._FindName.ResultsSeq[1:]
+	}
+	// body
+	if ctxErr != nil {
+		results = struct {
+			R0 string
+			R1 error
+		}{}
+		results.R1 = ctxErr
+	} else if recv._FindName.Body != nil {
+		results.R0, results.R1 = recv._FindName.Body(p0, p1)
+		recv._FindName.Results = results
+	}
+	// call history
+	recv._FindName.History = append(recv._FindName.History, struct {
+		Params struct {
+			P0 context.Context
+			P1 string
+		}
+		Results struct {
+			R0 string
+			R1 error
+		}
+	}{
+		Params:  recv._FindName.Params,
+		Results: results,
+	})
+	// results
+	return results.R0, results.R1
+}
+
+func (recv *MockcUserRepo) FindNameCallCount() int {
+	recv._FindName.mu.Lock()
+	defer recv._FindName.mu.Unlock()
+	return recv._FindName.CallCount
+}
+
+func (recv *MockcUserRepo) FindNameParams() struct {
+	P0 context.Context
+	P1 string
+} {
+	recv._FindName.mu.Lock()
+	defer recv._FindName.mu.Unlock()
+	return recv._FindName.Params
+}
+
+func (recv *MockcUserRepo) FindNameHistory() []struct {
+	Params struct {
+		P0 context.Context
+		P1 string
+	}
+	Results struct {
+		R0 string
+		R1 error
+	}
+} {
+	recv._FindName.mu.Lock()
+	defer recv._FindName.mu.Unlock()
+	return append(recv._FindName.History[:0:0], recv._FindName.History...)
+}
+
+func (recv *MockcUserRepo) SetFindNameResults(r0 string, r1 error) {
+	recv._FindName.mu.Lock()
+	defer recv._FindName.mu.Unlock()
+	recv._FindName.Results = struct {
+		R0 string
+		R1 error
+	}{
+		R0: r0,
+		R1: r1,
+	}
+}
+
+func (recv *MockcUserRepo) AppendFindNameResults(r0 string, r1 error) {
+	recv._FindName.mu.Lock()
+	defer recv._FindName.mu.Unlock()
+	recv._FindName.ResultsSeq = append(recv._FindName.ResultsSeq, struct {
+		R0 string
+		R1 error
+	}{
+		R0: r0,
+		R1: r1,
+	})
+}
+
+func (recv *MockcUserRepo) SetFindNameBody(body func(context.Context, string) (string, error)) {
+	recv._FindName.mu.Lock()
+	defer recv._FindName.mu.Unlock()
+	recv._FindName.Body = body
+}
+
+func (recv *MockcUserRepo) SetFindNameDelay(d time.Duration) {
+	recv._FindName.mu.Lock()
+	defer recv._FindName.mu.Unlock()
+	recv._FindName.Delay = d
+}
+
+func (recv *MockcUserRepo) SetFindNameRespectContext(v bool) {
+	recv._FindName.mu.Lock()
+	defer recv._FindName.mu.Unlock()
+	recv._FindName.RespectContext = v
+}
+
+func (recv *MockcUserRepo) WaitFindName(ctx context.Context, n int) error {
+	for {
+		recv._FindName.mu.Lock()
+		if recv._FindName.CallCount >= n {
+			recv._FindName.mu.Unlock()
+			return nil
+		}
+		if recv._FindName.notify == nil {
+			recv._FindName.notify = make(chan struct{})
+		}
+		notify := recv._FindName.notify
+		recv._FindName.mu.Unlock()
+
+		select {
+		case <-notify:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (recv *MockcUserRepo) FindNameCalled() <-chan struct{} {
+	recv._FindName.mu.Lock()
+	defer recv._FindName.mu.Unlock()
+	if recv._FindName.CallCount > 0 {
+		called := make(chan struct{})
+		close(called)
+		return called
+	}
+	if recv._FindName.notify == nil {
+		recv._FindName.notify = make(chan struct{})
+	}
+	return recv._FindName.notify
+}
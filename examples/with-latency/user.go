@@ -0,0 +1,24 @@
+package latency
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+type UserRepo interface {
+	FindName(ctx context.Context, id string) (name string, err error)
+}
+
+// Greet greets the user, and gives up finding the user's name after the timeout.
+func Greet(repo UserRepo, id string, timeout time.Duration) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	name, err := repo.FindName(ctx, id)
+	if err != nil {
+		return "", fmt.Errorf("cannot find user %s: %w", id, err)
+	}
+
+	return "Hello, " + name, nil
+}
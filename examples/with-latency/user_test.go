@@ -0,0 +1,85 @@
+package latency
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestGreet_Timeout(t *testing.T) {
+	m := &MockcUserRepo{}
+	m._FindName.Results.R0 = "mockc"
+
+	// respond slower than the timeout
+	m._FindName.Delay = time.Minute
+	m._FindName.RespectContext = true
+
+	// execute
+	_, err := Greet(m, "user:1", 10*time.Millisecond)
+
+	// assert
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("err should be %v: actual(%v)", context.DeadlineExceeded, err)
+	}
+	if m._FindName.CallCount != 1 {
+		t.Errorf("UserRepo.FindName should be called once: actual(%d)", m._FindName.CallCount)
+	}
+}
+
+func TestGreet_Delay(t *testing.T) {
+	m := &MockcUserRepo{}
+	m._FindName.Results.R0 = "mockc"
+
+	// respond faster than the timeout
+	m._FindName.Delay = 10 * time.Millisecond
+	m._FindName.RespectContext = true
+
+	// execute
+	start := time.Now()
+	greeting, err := Greet(m, "user:1", time.Minute)
+
+	// assert
+	if err != nil {
+		t.Errorf("err should be nil: actual(%v)", err)
+	}
+	if greeting != "Hello, mockc" {
+		t.Errorf("greeting should be 'Hello, mockc': actual(%s)", greeting)
+	}
+	if elapsed := time.Since(start); elapsed < 10*time.Millisecond {
+		t.Errorf("Greet should take at least the delay: actual(%s)", elapsed)
+	}
+}
+
+func TestGreet_Concurrent(t *testing.T) {
+	m := &MockcUserRepo{}
+	m.SetFindNameResults("mockc", nil)
+	m.SetFindNameDelay(10 * time.Millisecond)
+
+	// execute the overlapping calls, which sleep concurrently
+	var wg sync.WaitGroup
+	for _, id := range []string{"user:1", "user:2"} {
+		wg.Add(1)
+		go func(id string) {
+			defer wg.Done()
+			Greet(m, id, time.Minute)
+		}(id)
+	}
+	defer wg.Wait()
+
+	// the waiter returns after the calls have been recorded
+	err := m.WaitFindName(context.Background(), 2)
+	if err != nil {
+		t.Fatalf("err should be nil: actual(%v)", err)
+	}
+
+	// assert that each call is recorded with its own params
+	ids := map[string]bool{}
+	for _, call := range m.FindNameHistory() {
+		ids[call.Params.P1] = true
+	}
+	if len(ids) != 2 || !ids["user:1"] || !ids["user:2"] {
+		t.Errorf("UserRepo.FindName should be called with user:1 and user:2: actual(%v)", ids)
+	}
+}
@@ -0,0 +1,23 @@
+package matchers
+
+type Cache interface {
+	Get(key string) (val interface{}, err error)
+	Set(key string, val interface{}) (err error)
+	Del(key string) (err error)
+}
+
+type User struct {
+	ID   string
+	Name string
+}
+
+func SaveUsers(c Cache, users []User) error {
+	for _, user := range users {
+		err := c.Set("user:"+user.ID, user)
+		if err != nil {
+			return err
+		}
+	}
+
+	return c.Set("users:count", len(users))
+}
@@ -0,0 +1,35 @@
+package matchers
+
+import (
+	"testing"
+
+	"github.com/KimMachineGun/mockc/match"
+)
+
+func TestSaveUsers(t *testing.T) {
+	m := &MockcCache{}
+
+	// execute
+	users := []User{
+		{ID: "1", Name: "foo"},
+		{ID: "2", Name: "bar"},
+	}
+	err := SaveUsers(m, users)
+
+	// assert
+	if err != nil {
+		t.Error("err should be nil")
+	}
+	if calls := m.FindSetCalls(match.Regex("^user:")); len(calls) != len(users) {
+		t.Errorf("Cache.Set should be called %d times with the key prefix user: actual(%d)", len(users), len(calls))
+	}
+	calls := m.FindSetCalls(match.Eq("user:2"), match.Func(func(user User) bool {
+		return user.Name == "bar"
+	}))
+	if len(calls) != 1 {
+		t.Errorf("Cache.Set should be called once with the user bar: actual(%d)", len(calls))
+	}
+	if calls := m.FindSetCalls(match.Eq("users:count"), match.Eq(len(users))); len(calls) != 1 {
+		t.Errorf("Cache.Set should be called once with the user count: actual(%d)", len(calls))
+	}
+}
@@ -0,0 +1,12 @@
+//+build mockc
+
+package matchers
+
+import (
+	"github.com/KimMachineGun/mockc"
+)
+
+func MockcCache() {
+	mockc.Implement(Cache(nil))
+	mockc.WithMatchers()
+}
@@ -0,0 +1,308 @@
+// Code generated by Mockc. DO NOT EDIT.
+// repo: https://github.com/KimMachineGun/mockc
+
+//go:generate mockc
+//go:build !mockc
+// +build !mockc
+
+package matchers
+
+import (
+	match "github.com/KimMachineGun/mockc/match"
+	"sync"
+)
+
+var _ interface {
+	Cache
+} = &MockcCache{}
+
+type MockcCache struct {
+	// method: Del
+	_Del struct {
+		mu sync.Mutex
+		// basics
+		Called    bool
+		CallCount int
+		// call history
+		History []struct {
+			Params struct {
+				P0 string
+			}
+			Results struct {
+				R0 error
+			}
+		}
+		// params
+		Params struct {
+			P0 string
+		}
+		// results
+		Results struct {
+			R0 error
+		}
+		// if it is not empty, its first element will be consumed instead of the results.
+		ResultsSeq []struct {
+			R0 error
+		}
+		// if it is not nil, it'll be called in the middle of the method.
+		Body func(string) error
+	}
+	// method: Get
+	_Get struct {
+		mu sync.Mutex
+		// basics
+		Called    bool
+		CallCount int
+		// call history
+		History []struct {
+			Params struct {
+				P0 string
+			}
+			Results struct {
+				R0 interface{}
+				R1 error
+			}
+		}
+		// params
+		Params struct {
+			P0 string
+		}
+		// results
+		Results struct {
+			R0 interface{}
+			R1 error
+		}
+		// if it is not empty, its first element will be consumed instead of the results.
+		ResultsSeq []struct {
+			R0 interface{}
+			R1 error
+		}
+		// if it is not nil, it'll be called in the middle of the method.
+		Body func(string) (interface{}, error)
+	}
+	// method: Set
+	_Set struct {
+		mu sync.Mutex
+		// basics
+		Called    bool
+		CallCount int
+		// call history
+		History []struct {
+			Params struct {
+				P0 string
+				P1 interface{}
+			}
+			Results struct {
+				R0 error
+			}
+		}
+		// params
+		Params struct {
+			P0 string
+			P1 interface{}
+		}
+		// results
+		Results struct {
+			R0 error
+		}
+		// if it is not empty, its first element will be consumed instead of the results.
+		ResultsSeq []struct {
+			R0 error
+		}
+		// if it is not nil, it'll be called in the middle of the method.
+		Body func(string, interface{}) error
+	}
+}
+
+func (recv *MockcCache) Del(p0 string) error {
+	recv._Del.mu.Lock()
+	defer recv._Del.mu.Unlock()
+	// basics
+	recv._Del.Called = true
+	recv._Del.CallCount++
+	// params
+	recv._Del.Params.P0 = p0
+	// default results
+	results := recv._Del.Results
+	// results sequence
+	if recv._Del.Body == nil && len(recv._Del.ResultsSeq) > 0 {
+		results = recv._Del.ResultsSeq[0]
+		recv._Del.ResultsSeq = recv._Del.ResultsSeq[1:]
+	}
+	// body
+	if recv._Del.Body != nil {
+		results.R0 = recv._Del.Body(p0)
+		recv._Del.Results = results
+	}
+	// call history
+	recv._Del.History = append(recv._Del.History, struct {
+		Params struct {
+			P0 string
+		}
+		Results struct {
+			R0 error
+		}
+	}{
+		Params:  recv._Del.Params,
+		Results: results,
+	})
+	// results
+	return results.R0
+}
+
+func (recv *MockcCache) Get(p0 string) (interface{}, error) {
+	recv._Get.mu.Lock()
+	defer recv._Get.mu.Unlock()
+	// basics
+	recv._Get.Called = true
+	recv._Get.CallCount++
+	// params
+	recv._Get.Params.P0 = p0
+	// default results
+	results := recv._Get.Results
+	// results sequence
+	if recv._Get.Body == nil && len(recv._Get.ResultsSeq) > 0 {
+		results = recv._Get.ResultsSeq[0]
+		recv._Get.ResultsSeq = recv._Get.ResultsSeq[1:]
+	}
+	// body
+	if recv._Get.Body != nil {
+		results.R0, results.R1 = recv._Get.Body(p0)
+		recv._Get.Results = results
+	}
+	// call history
+	recv._Get.History = append(recv._Get.History, struct {
+		Params struct {
+			P0 string
+		}
+		Results struct {
+			R0 interface{}
+			R1 error
+		}
+	}{
+		Params:  recv._Get.Params,
+		Results: results,
+	})
+	// results
+	return results.R0, results.R1
+}
+
+func (recv *MockcCache) Set(p0 string, p1 interface{}) error {
+	recv._Set.mu.Lock()
+	defer recv._Set.mu.Unlock()
+	// basics
+	recv._Set.Called = true
+	recv._Set.CallCount++
+	// params
+	recv._Set.Params.P0 = p0
+	recv._Set.Params.P1 = p1
+	// default results
+	results := recv._Set.Results
+	// results sequence
+	if recv._Set.Body == nil && len(recv._Set.ResultsSeq) > 0 {
+		results = recv._Set.ResultsSeq[0]
+		recv._Set.ResultsSeq = recv._Set.ResultsSeq[1:]
+	}
+	// body
+	if recv._Set.Body != nil {
+		results.R0 = recv._Set.Body(p0, p1)
+		recv._Set.Results = results
+	}
+	// call history
+	recv._Set.History = append(recv._Set.History, struct {
+		Params struct {
+			P0 string
+			P1 interface{}
+		}
+		Results struct {
+			R0 error
+		}
+	}{
+		Params:  recv._Set.Params,
+		Results: results,
+	})
+	// results
+	return results.R0
+}
+
+func (recv *MockcCache) FindDelCalls(matchers ...match.Matcher) []struct {
+	Params struct {
+		P0 string
+	}
+	Results struct {
+		R0 error
+	}
+} {
+	recv._Del.mu.Lock()
+	defer recv._Del.mu.Unlock()
+	var calls []struct {
+		Params struct {
+			P0 string
+		}
+		Results struct {
+			R0 error
+		}
+	}
+	for _, call := range recv._Del.History {
+		if match.Args(matchers, call.Params.P0) {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+func (recv *MockcCache) FindGetCalls(matchers ...match.Matcher) []struct {
+	Params struct {
+		P0 string
+	}
+	Results struct {
+		R0 interface{}
+		R1 error
+	}
+} {
+	recv._Get.mu.Lock()
+	defer recv._Get.mu.Unlock()
+	var calls []struct {
+		Params struct {
+			P0 string
+		}
+		Results struct {
+			R0 interface{}
+			R1 error
+		}
+	}
+	for _, call := range recv._Get.History {
+		if match.Args(matchers, call.Params.P0) {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+func (recv *MockcCache) FindSetCalls(matchers ...match.Matcher) []struct {
+	Params struct {
+		P0 string
+		P1 interface{}
+	}
+	Results struct {
+		R0 error
+	}
+} {
+	recv._Set.mu.Lock()
+	defer recv._Set.mu.Unlock()
+	var calls []struct {
+		Params struct {
+			P0 string
+			P1 interface{}
+		}
+		Results struct {
+			R0 error
+		}
+	}
+	for _, call := range recv._Set.History {
+		if match.Args(matchers, call.Params.P0, call.Params.P1) {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
@@ -0,0 +1,12 @@
+//+build mockc
+
+package recordreplay
+
+import (
+	"github.com/KimMachineGun/mockc"
+)
+
+func MockcRates() {
+	mockc.Implement(Rates(nil))
+	mockc.WithRecordReplay()
+}
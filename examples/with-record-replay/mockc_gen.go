@@ -0,0 +1,148 @@
+// Code generated by Mockc. DO NOT EDIT.
+// repo: https://github.com/KimMachineGun/mockc
+
+//go:generate mockc
+//go:build !mockc
+// +build !mockc
+
+package recordreplay
+
+import (
+	"context"
+	mockc "github.com/KimMachineGun/mockc"
+	"sync"
+	"testing"
+)
+
+var _ interface {
+	Rates
+} = &MockcRates{}
+
+type MockcRates struct {
+	delegate interface {
+		Rates
+	}
+	fixture *mockc.Fixture
+	// method: Rate
+	_Rate struct {
+		mu sync.Mutex
+		// basics
+		Called    bool
+		CallCount int
+		// call history
+		History []struct {
+			Params struct {
+				P0 context.Context
+				P1 string
+				P2 string
+			}
+			Results struct {
+				R0 float64
+				R1 error
+			}
+		}
+		// params
+		Params struct {
+			P0 context.Context
+			P1 string
+			P2 string
+		}
+		// results
+		Results struct {
+			R0 float64
+			R1 error
+		}
+		// if it is not empty, its first element will be consumed instead of the results.
+		ResultsSeq []struct {
+			R0 float64
+			R1 error
+		}
+		// if it is not nil, it'll be called in the middle of the method.
+		Body func(context.Context, string, string) (float64, error)
+	}
+}
+
+func NewMockcRates(delegate interface {
+	Rates
+}) *MockcRates {
+	m := &MockcRates{delegate: delegate}
+	return m
+}
+
+func (recv *MockcRates) Rate(p0 context.Context, p1 string, p2 string) (float64, error) {
+	recv._Rate.mu.Lock()
+	defer recv._Rate.mu.Unlock()
+	// basics
+	recv._Rate.Called = true
+	recv._Rate.CallCount++
+	// params
+	recv._Rate.Params.P0 = p0
+	recv._Rate.Params.P1 = p1
+	recv._Rate.Params.P2 = p2
+	// default results
+	results := recv._Rate.Results
+	supplied := false
+	// results sequence
+	if recv._Rate.Body == nil && len(recv._Rate.ResultsSeq) > 0 {
+		results = recv._Rate.ResultsSeq[0]
+		recv._Rate.ResultsSeq = recv._Rate.ResultsSeq[1:]
+		supplied = true
+	}
+	// body
+	if recv._Rate.Body != nil {
+		results.R0, results.R1 = recv._Rate.Body(p0, p1, p2)
+		recv._Rate.Results = results
+	} else if recv.fixture != nil && !recv.fixture.Recording() {
+		recv.fixture.Replay("Rate", []interface{}{p0, p1, p2}, &results.R0, &results.R1)
+	} else if recv.delegate != nil && !supplied {
+		results.R0, results.R1 = recv.delegate.Rate(p0, p1, p2)
+	}
+	// record
+	if recv.fixture != nil && recv.fixture.Recording() {
+		recv.fixture.Record("Rate", []interface{}{p0, p1, p2}, []interface{}{results.R0, results.R1})
+	}
+	// call history
+	recv._Rate.History = append(recv._Rate.History, struct {
+		Params struct {
+			P0 context.Context
+			P1 string
+			P2 string
+		}
+		Results struct {
+			R0 float64
+			R1 error
+		}
+	}{
+		Params:  recv._Rate.Params,
+		Results: results,
+	})
+	// results
+	return results.R0, results.R1
+}
+
+func (recv *MockcRates) Delegate() interface {
+	Rates
+} {
+	return recv.delegate
+}
+
+func (recv *MockcRates) RestoreRate() {
+	recv._Rate.mu.Lock()
+	defer recv._Rate.mu.Unlock()
+	recv._Rate.Body = nil
+}
+
+func (recv *MockcRates) Record(t testing.TB, path string) {
+	t.Helper()
+	recv.fixture = mockc.NewFixture(t, "MockcRates", path, true)
+}
+
+func (recv *MockcRates) Replay(t testing.TB, path string) {
+	t.Helper()
+	recv.fixture = mockc.NewFixture(t, "MockcRates", path, false)
+}
+
+func (recv *MockcRates) UseFixture(t testing.TB, path string) {
+	t.Helper()
+	recv.fixture = mockc.NewFixture(t, "MockcRates", path, mockc.RecordingFixtures())
+}
@@ -0,0 +1,46 @@
+package recordreplay
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+type Rates interface {
+	Rate(ctx context.Context, from string, to string) (rate float64, err error)
+}
+
+// SlowRates is the real implementation of Rates, which takes a while to respond.
+type SlowRates struct {
+	Latency time.Duration
+	Table   map[string]float64
+}
+
+func (r *SlowRates) Rate(ctx context.Context, from string, to string) (float64, error) {
+	select {
+	case <-time.After(r.Latency):
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	}
+
+	rate, ok := r.Table[from+"/"+to]
+	if !ok {
+		return 0, fmt.Errorf("unknown pair: %s/%s", from, to)
+	}
+
+	return rate, nil
+}
+
+// Convert converts the amount of the currency into the other currencies.
+func Convert(ctx context.Context, rates Rates, amount float64, from string, to ...string) (map[string]float64, error) {
+	converted := make(map[string]float64, len(to))
+	for _, currency := range to {
+		rate, err := rates.Rate(ctx, from, currency)
+		if err != nil {
+			return nil, err
+		}
+		converted[currency] = amount * rate
+	}
+
+	return converted, nil
+}
@@ -0,0 +1,76 @@
+package recordreplay
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/KimMachineGun/mockc/examples/internal/recorder"
+)
+
+var slowRates = &SlowRates{
+	Latency: time.Second,
+	Table: map[string]float64{
+		"USD/EUR": 0.5,
+		"USD/KRW": 1000,
+	},
+}
+
+func TestConvert(t *testing.T) {
+	m := NewMockcRates(slowRates)
+
+	// run 'MOCKC_RECORD=1 go test' to record the calls to the slow rates
+	m.UseFixture(t, "testdata/convert.json")
+
+	// execute
+	converted, err := Convert(context.Background(), m, 10, "USD", "EUR", "KRW")
+
+	// assert
+	if err != nil {
+		t.Errorf("err should be nil: actual(%v)", err)
+	}
+	if converted["EUR"] != 5 || converted["KRW"] != 10000 {
+		t.Errorf("converted should be map[EUR:5 KRW:10000]: actual(%v)", converted)
+	}
+}
+
+func TestConvert_UnknownPair(t *testing.T) {
+	// the delegate is not needed to replay the fixture
+	m := NewMockcRates(nil)
+
+	// replay the recorded error
+	m.Replay(t, "testdata/convert_unknown_pair.json")
+
+	// execute
+	_, err := Convert(context.Background(), m, 10, "USD", "JPY")
+
+	// assert
+	if err == nil || err.Error() != "unknown pair: USD/JPY" {
+		t.Errorf("err should be the recorded error: actual(%v)", err)
+	}
+	if m._Rate.CallCount != 1 {
+		t.Errorf("Rates.Rate should be called once: actual(%d)", m._Rate.CallCount)
+	}
+}
+
+func TestConvert_NotReplayed(t *testing.T) {
+	m := NewMockcRates(nil)
+
+	// replay the fixture that has recorded the conversions to EUR and KRW
+	rec := recorder.New(t)
+	m.Replay(rec, "testdata/convert.json")
+
+	// execute only the conversion to EUR
+	Convert(context.Background(), m, 10, "USD", "EUR")
+
+	// verify
+	rec.RunCleanups()
+
+	expected := []string{
+		`mockc: MockcRates.Rate(null, "USD", "KRW"): recorded in testdata/convert.json but not replayed`,
+	}
+	if strings.Join(rec.Errors, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("unexpected errors: %q", rec.Errors)
+	}
+}
@@ -0,0 +1,27 @@
+package reset
+
+type Cache interface {
+	Get(key string) (val interface{}, err error)
+	Set(key string, val interface{}) (err error)
+}
+
+type Service struct {
+	cache Cache
+}
+
+func NewService(cache Cache) *Service {
+	return &Service{
+		cache: cache,
+	}
+}
+
+func (s *Service) Name(id string) (string, error) {
+	val, err := s.cache.Get("name:" + id)
+	if err != nil {
+		return "", err
+	}
+
+	name, _ := val.(string)
+
+	return name, nil
+}
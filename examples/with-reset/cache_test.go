@@ -0,0 +1,54 @@
+package reset
+
+import (
+	"testing"
+
+	"github.com/KimMachineGun/mockc"
+)
+
+func TestService_Name(t *testing.T) {
+	// the mock is wired into the service once, and reused across the subtests
+	m := &MockcCache{}
+	s := NewService(m)
+
+	m._Get.Results.R0 = "foo"
+
+	t.Run("first", func(t *testing.T) {
+		defer m.Reset(mockc.KeepResults)
+
+		name, err := s.Name("1")
+		if err != nil {
+			t.Error("err should be nil")
+		}
+		if name != "foo" {
+			t.Errorf("name should be %q: actual(%q)", "foo", name)
+		}
+		if m._Get.CallCount != 1 {
+			t.Errorf("Cache.Get should be called once: actual(%d)", m._Get.CallCount)
+		}
+	})
+
+	t.Run("second", func(t *testing.T) {
+		defer m.Reset()
+
+		name, err := s.Name("2")
+		if err != nil {
+			t.Error("err should be nil")
+		}
+		// the results are kept by the previous reset
+		if name != "foo" {
+			t.Errorf("name should be %q: actual(%q)", "foo", name)
+		}
+		// but the recorded calls are cleared
+		if m._Get.CallCount != 1 {
+			t.Errorf("Cache.Get should be called once: actual(%d)", m._Get.CallCount)
+		}
+		if m._Get.History[0].Params.P0 != "name:2" {
+			t.Errorf("Cache.Get should be called with %q: actual(%q)", "name:2", m._Get.History[0].Params.P0)
+		}
+	})
+
+	if m._Get.Called || m._Get.Results.R0 != nil {
+		t.Error("Cache.Get should be reset")
+	}
+}
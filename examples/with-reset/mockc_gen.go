@@ -0,0 +1,207 @@
+// Code generated by Mockc. DO NOT EDIT.
+// repo: https://github.com/KimMachineGun/mockc
+
+//go:generate mockc
+//go:build !mockc
+// +build !mockc
+
+package reset
+
+import (
+	mockc "github.com/KimMachineGun/mockc"
+	"sync"
+)
+
+var _ interface {
+	Cache
+} = &MockcCache{}
+
+type MockcCache struct {
+	// method: Get
+	_Get struct {
+		mu sync.Mutex
+		// basics
+		Called    bool
+		CallCount int
+		// call history
+		History []struct {
+			Params struct {
+				P0 string
+			}
+			Results struct {
+				R0 interface{}
+				R1 error
+			}
+		}
+		// params
+		Params struct {
+			P0 string
+		}
+		// results
+		Results struct {
+			R0 interface{}
+			R1 error
+		}
+		// if it is not empty, its first element will be consumed instead of the results.
+		ResultsSeq []struct {
+			R0 interface{}
+			R1 error
+		}
+		// if it is not nil, it'll be called in the middle of the method.
+		Body func(string) (interface{}, error)
+	}
+	// method: Set
+	_Set struct {
+		mu sync.Mutex
+		// basics
+		Called    bool
+		CallCount int
+		// call history
+		History []struct {
+			Params struct {
+				P0 string
+				P1 interface{}
+			}
+			Results struct {
+				R0 error
+			}
+		}
+		// params
+		Params struct {
+			P0 string
+			P1 interface{}
+		}
+		// results
+		Results struct {
+			R0 error
+		}
+		// if it is not empty, its first element will be consumed instead of the results.
+		ResultsSeq []struct {
+			R0 error
+		}
+		// if it is not nil, it'll be called in the middle of the method.
+		Body func(string, interface{}) error
+	}
+}
+
+func (recv *MockcCache) Get(p0 string) (interface{}, error) {
+	recv._Get.mu.Lock()
+	defer recv._Get.mu.Unlock()
+	// basics
+	recv._Get.Called = true
+	recv._Get.CallCount++
+	// params
+	recv._Get.Params.P0 = p0
+	// default results
+	results := recv._Get.Results
+	// results sequence
+	if recv._Get.Body == nil && len(recv._Get.ResultsSeq) > 0 {
+		results = recv._Get.ResultsSeq[0]
+		recv._Get.ResultsSeq = recv._Get.ResultsSeq[1:]
+	}
+	// body
+	if recv._Get.Body != nil {
+		results.R0, results.R1 = recv._Get.Body(p0)
+		recv._Get.Results = results
+	}
+	// call history
+	recv._Get.History = append(recv._Get.History, struct {
+		Params struct {
+			P0 string
+		}
+		Results struct {
+			R0 interface{}
+			R1 error
+		}
+	}{
+		Params:  recv._Get.Params,
+		Results: results,
+	})
+	// results
+	return results.R0, results.R1
+}
+
+func (recv *MockcCache) Set(p0 string, p1 interface{}) error {
+	recv._Set.mu.Lock()
+	defer recv._Set.mu.Unlock()
+	// basics
+	recv._Set.Called = true
+	recv._Set.CallCount++
+	// params
+	recv._Set.Params.P0 = p0
+	recv._Set.Params.P1 = p1
+	// default results
+	results := recv._Set.Results
+	// results sequence
+	if recv._Set.Body == nil && len(recv._Set.ResultsSeq) > 0 {
+		results = recv._Set.ResultsSeq[0]
+		recv._Set.ResultsSeq = recv._Set.ResultsSeq[1:]
+	}
+	// body
+	if recv._Set.Body != nil {
+		results.R0 = recv._Set.Body(p0, p1)
+		recv._Set.Results = results
+	}
+	// call history
+	recv._Set.History = append(recv._Set.History, struct {
+		Params struct {
+			P0 string
+			P1 interface{}
+		}
+		Results struct {
+			R0 error
+		}
+	}{
+		Params:  recv._Set.Params,
+		Results: results,
+	})
+	// results
+	return results.R0
+}
+
+func (recv *MockcCache) Reset(opts ...mockc.ResetOption) {
+	recv.ResetGet(opts...)
+	recv.ResetSet(opts...)
+}
+
+func (recv *MockcCache) ResetGet(opts ...mockc.ResetOption) {
+	recv._Get.mu.Lock()
+	defer recv._Get.mu.Unlock()
+	recv._Get.Called = false
+	recv._Get.CallCount = 0
+	recv._Get.History = nil
+	recv._Get.Params = struct {
+		P0 string
+	}{}
+	if !mockc.KeepBody.Keeps(opts) {
+		recv._Get.Body = nil
+	}
+	if !mockc.KeepResults.Keeps(opts) {
+		recv._Get.Results = struct {
+			R0 interface{}
+			R1 error
+		}{}
+		recv._Get.ResultsSeq = nil
+	}
+}
+
+func (recv *MockcCache) ResetSet(opts ...mockc.ResetOption) {
+	recv._Set.mu.Lock()
+	defer recv._Set.mu.Unlock()
+	recv._Set.Called = false
+	recv._Set.CallCount = 0
+	recv._Set.History = nil
+	recv._Set.Params = struct {
+		P0 string
+		P1 interface{}
+	}{}
+	if !mockc.KeepBody.Keeps(opts) {
+		recv._Set.Body = nil
+	}
+	if !mockc.KeepResults.Keeps(opts) {
+		recv._Set.Results = struct {
+			R0 error
+		}{}
+		recv._Set.ResultsSeq = nil
+	}
+}
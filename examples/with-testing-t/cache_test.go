@@ -0,0 +1,79 @@
+package testingt
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/KimMachineGun/mockc/examples/internal/recorder"
+)
+
+func HasKey(c Cache, key string) (bool, error) {
+	val, err := c.Get(key)
+	if err != nil {
+		return false, err
+	}
+
+	return val != nil, nil
+}
+
+func TestHasKey_WithTestingT(t *testing.T) {
+	// the mock will be verified when the test finishes
+	m := NewMockcCache(t)
+
+	// set return value
+	m._Get.Results.R0 = struct{}{}
+	m._Get.Required = true
+
+	// execute
+	result, err := HasKey(m, "test_key")
+
+	// assert
+	if !result {
+		t.Error("result should be true")
+	}
+	if err != nil {
+		t.Error("err should be nil")
+	}
+}
+
+func TestHasKey_WithFailedVerification(t *testing.T) {
+	rec := recorder.New(t)
+	m := NewMockcCache(rec)
+
+	m._Del.Required = true
+
+	// execute without configuring Cache.Get
+	HasKey(m, "test_key")
+
+	// verify
+	rec.RunCleanups()
+
+	expected := []string{
+		`MockcCache.Del: required but never called`,
+		`MockcCache.Get("test_key"): called without configured behavior`,
+	}
+	if strings.Join(rec.Errors, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("unexpected errors: %q", rec.Errors)
+	}
+}
+
+func TestRange_WithFailedVerification(t *testing.T) {
+	rec := recorder.New(t)
+	m := NewMockcCache(rec)
+
+	// execute without configuring Cache.Range
+	m.Range(context.Background(), func(key string, val interface{}) bool {
+		return true
+	})
+
+	// verify
+	rec.RunCleanups()
+
+	expected := []string{
+		`MockcCache.Range(ctx, <func(string, interface {}) bool>): called without configured behavior`,
+	}
+	if strings.Join(rec.Errors, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("unexpected errors: %q", rec.Errors)
+	}
+}
@@ -0,0 +1,12 @@
+//+build mockc
+
+package testingt
+
+import (
+	"github.com/KimMachineGun/mockc"
+)
+
+func MockcCache() {
+	mockc.Implement(Cache(nil))
+	mockc.WithTestingT()
+}
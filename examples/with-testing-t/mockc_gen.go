@@ -0,0 +1,436 @@
+// Code generated by Mockc. DO NOT EDIT.
+// repo: https://github.com/KimMachineGun/mockc
+
+//go:generate mockc
+//go:build !mockc
+// +build !mockc
+
+package testingt
+
+import (
+	"context"
+	mockc "github.com/KimMachineGun/mockc"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+var _ interface {
+	Cache
+} = &MockcCache{}
+
+type MockcCache struct {
+	t testing.TB
+	// method: Del
+	_Del struct {
+		mu sync.Mutex
+		// basics
+		Called    bool
+		CallCount int
+		// call history
+		History []struct {
+			Params struct {
+				P0 string
+			}
+			Results struct {
+				R0 error
+			}
+		}
+		// params
+		Params struct {
+			P0 string
+		}
+		// results
+		Results struct {
+			R0 error
+		}
+		// if it is not empty, its first element will be consumed instead of the results.
+		ResultsSeq []struct {
+			R0 error
+		}
+		// if it is true, the method should be called at least once.
+		Required          bool
+		unconfiguredCalls []string
+		// it is true if the results have been set by SetDelResults, even if they are zero.
+		configured bool
+		// if it is not nil, it'll be called in the middle of the method.
+		Body func(string) error
+	}
+	// method: Get
+	_Get struct {
+		mu sync.Mutex
+		// basics
+		Called    bool
+		CallCount int
+		// call history
+		History []struct {
+			Params struct {
+				P0 string
+			}
+			Results struct {
+				R0 interface{}
+				R1 error
+			}
+		}
+		// params
+		Params struct {
+			P0 string
+		}
+		// results
+		Results struct {
+			R0 interface{}
+			R1 error
+		}
+		// if it is not empty, its first element will be consumed instead of the results.
+		ResultsSeq []struct {
+			R0 interface{}
+			R1 error
+		}
+		// if it is true, the method should be called at least once.
+		Required          bool
+		unconfiguredCalls []string
+		// it is true if the results have been set by SetGetResults, even if they are zero.
+		configured bool
+		// if it is not nil, it'll be called in the middle of the method.
+		Body func(string) (interface{}, error)
+	}
+	// method: Range
+	_Range struct {
+		mu sync.Mutex
+		// basics
+		Called    bool
+		CallCount int
+		// call history
+		History []struct {
+			Params struct {
+				P0 context.Context
+				P1 func(string, interface{}) bool
+			}
+			Results struct {
+				R0 error
+			}
+		}
+		// params
+		Params struct {
+			P0 context.Context
+			P1 func(string, interface{}) bool
+		}
+		// results
+		Results struct {
+			R0 error
+		}
+		// if it is not empty, its first element will be consumed instead of the results.
+		ResultsSeq []struct {
+			R0 error
+		}
+		// if it is true, the method should be called at least once.
+		Required          bool
+		unconfiguredCalls []string
+		// it is true if the results have been set by SetRangeResults, even if they are zero.
+		configured bool
+		// if it is not nil, it'll be called in the middle of the method.
+		Body func(context.Context, func(string, interface{}) bool) error
+	}
+	// method: Set
+	_Set struct {
+		mu sync.Mutex
+		// basics
+		Called    bool
+		CallCount int
+		// call history
+		History []struct {
+			Params struct {
+				P0 string
+				P1 interface{}
+			}
+			Results struct {
+				R0 error
+			}
+		}
+		// params
+		Params struct {
+			P0 string
+			P1 interface{}
+		}
+		// results
+		Results struct {
+			R0 error
+		}
+		// if it is not empty, its first element will be consumed instead of the results.
+		ResultsSeq []struct {
+			R0 error
+		}
+		// if it is true, the method should be called at least once.
+		Required          bool
+		unconfiguredCalls []string
+		// it is true if the results have been set by SetSetResults, even if they are zero.
+		configured bool
+		// if it is not nil, it'll be called in the middle of the method.
+		Body func(string, interface{}) error
+	}
+}
+
+func NewMockcCache(t testing.TB, v ...interface {
+	Cache
+}) *MockcCache {
+	m := &MockcCache{t: t}
+	if len(v) > 0 {
+		m._Del.Body = v[0].Del
+		m._Get.Body = v[0].Get
+		m._Range.Body = v[0].Range
+		m._Set.Body = v[0].Set
+	}
+	t.Cleanup(func() {
+		m.verify()
+	})
+	return m
+}
+
+func (recv *MockcCache) Del(p0 string) error {
+	recv._Del.mu.Lock()
+	defer recv._Del.mu.Unlock()
+	// basics
+	recv._Del.Called = true
+	recv._Del.CallCount++
+	// params
+	recv._Del.Params.P0 = p0
+	// default results
+	results := recv._Del.Results
+	configured := recv._Del.configured || !reflect.ValueOf(results).IsZero()
+	// results sequence
+	if recv._Del.Body == nil && len(recv._Del.ResultsSeq) > 0 {
+		results = recv._Del.ResultsSeq[0]
+		recv._Del.ResultsSeq = recv._Del.ResultsSeq[1:]
+		configured = true
+	}
+	// unconfigured calls
+	if !configured && recv._Del.Body == nil {
+		recv._Del.unconfiguredCalls = append(recv._Del.unconfiguredCalls, mockc.FormatCall("Del", p0))
+	}
+	// body
+	if recv._Del.Body != nil {
+		results.R0 = recv._Del.Body(p0)
+		recv._Del.Results = results
+	}
+	// call history
+	recv._Del.History = append(recv._Del.History, struct {
+		Params struct {
+			P0 string
+		}
+		Results struct {
+			R0 error
+		}
+	}{
+		Params:  recv._Del.Params,
+		Results: results,
+	})
+	// results
+	return results.R0
+}
+
+func (recv *MockcCache) Get(p0 string) (interface{}, error) {
+	recv._Get.mu.Lock()
+	defer recv._Get.mu.Unlock()
+	// basics
+	recv._Get.Called = true
+	recv._Get.CallCount++
+	// params
+	recv._Get.Params.P0 = p0
+	// default results
+	results := recv._Get.Results
+	configured := recv._Get.configured || !reflect.ValueOf(results).IsZero()
+	// results sequence
+	if recv._Get.Body == nil && len(recv._Get.ResultsSeq) > 0 {
+		results = recv._Get.ResultsSeq[0]
+		recv._Get.ResultsSeq = recv._Get.ResultsSeq[1:]
+		configured = true
+	}
+	// unconfigured calls
+	if !configured && recv._Get.Body == nil {
+		recv._Get.unconfiguredCalls = append(recv._Get.unconfiguredCalls, mockc.FormatCall("Get", p0))
+	}
+	// body
+	if recv._Get.Body != nil {
+		results.R0, results.R1 = recv._Get.Body(p0)
+		recv._Get.Results = results
+	}
+	// call history
+	recv._Get.History = append(recv._Get.History, struct {
+		Params struct {
+			P0 string
+		}
+		Results struct {
+			R0 interface{}
+			R1 error
+		}
+	}{
+		Params:  recv._Get.Params,
+		Results: results,
+	})
+	// results
+	return results.R0, results.R1
+}
+
+func (recv *MockcCache) Range(p0 context.Context, p1 func(string, interface{}) bool) error {
+	recv._Range.mu.Lock()
+	defer recv._Range.mu.Unlock()
+	// basics
+	recv._Range.Called = true
+	recv._Range.CallCount++
+	// params
+	recv._Range.Params.P0 = p0
+	recv._Range.Params.P1 = p1
+	// default results
+	results := recv._Range.Results
+	configured := recv._Range.configured || !reflect.ValueOf(results).IsZero()
+	// results sequence
+	if recv._Range.Body == nil && len(recv._Range.ResultsSeq) > 0 {
+		results = recv._Range.ResultsSeq[0]
+		recv._Range.ResultsSeq = recv._Range.ResultsSeq[1:]
+		configured = true
+	}
+	// unconfigured calls
+	if !configured && recv._Range.Body == nil {
+		recv._Range.unconfiguredCalls = append(recv._Range.unconfiguredCalls, mockc.FormatCall("Range", p0, p1))
+	}
+	// body
+	if recv._Range.Body != nil {
+		results.R0 = recv._Range.Body(p0, p1)
+		recv._Range.Results = results
+	}
+	// call history
+	recv._Range.History = append(recv._Range.History, struct {
+		Params struct {
+			P0 context.Context
+			P1 func(string, interface{}) bool
+		}
+		Results struct {
+			R0 error
+		}
+	}{
+		Params:  recv._Range.Params,
+		Results: results,
+	})
+	// results
+	return results.R0
+}
+
+func (recv *MockcCache) Set(p0 string, p1 interface{}) error {
+	recv._Set.mu.Lock()
+	defer recv._Set.mu.Unlock()
+	// basics
+	recv._Set.Called = true
+	recv._Set.CallCount++
+	// params
+	recv._Set.Params.P0 = p0
+	recv._Set.Params.P1 = p1
+	// default results
+	results := recv._Set.Results
+	configured := recv._Set.configured || !reflect.ValueOf(results).IsZero()
+	// results sequence
+	if recv._Set.Body == nil && len(recv._Set.ResultsSeq) > 0 {
+		results = recv._Set.ResultsSeq[0]
+		recv._Set.ResultsSeq = recv._Set.ResultsSeq[1:]
+		configured = true
+	}
+	// unconfigured calls
+	if !configured && recv._Set.Body == nil {
+		recv._Set.unconfiguredCalls = append(recv._Set.unconfiguredCalls, mockc.FormatCall("Set", p0, p1))
+	}
+	// body
+	if recv._Set.Body != nil {
+		results.R0 = recv._Set.Body(p0, p1)
+		recv._Set.Results = results
+	}
+	// call history
+	recv._Set.History = append(recv._Set.History, struct {
+		Params struct {
+			P0 string
+			P1 interface{}
+		}
+		Results struct {
+			R0 error
+		}
+	}{
+		Params:  recv._Set.Params,
+		Results: results,
+	})
+	// results
+	return results.R0
+}
+
+func (recv *MockcCache) SetDelResults(r0 error) {
+	recv._Del.mu.Lock()
+	defer recv._Del.mu.Unlock()
+	recv._Del.Results = struct {
+		R0 error
+	}{R0: r0}
+	recv._Del.configured = true
+}
+
+func (recv *MockcCache) SetGetResults(r0 interface{}, r1 error) {
+	recv._Get.mu.Lock()
+	defer recv._Get.mu.Unlock()
+	recv._Get.Results = struct {
+		R0 interface{}
+		R1 error
+	}{
+		R0: r0,
+		R1: r1,
+	}
+	recv._Get.configured = true
+}
+
+func (recv *MockcCache) SetRangeResults(r0 error) {
+	recv._Range.mu.Lock()
+	defer recv._Range.mu.Unlock()
+	recv._Range.Results = struct {
+		R0 error
+	}{R0: r0}
+	recv._Range.configured = true
+}
+
+func (recv *MockcCache) SetSetResults(r0 error) {
+	recv._Set.mu.Lock()
+	defer recv._Set.mu.Unlock()
+	recv._Set.Results = struct {
+		R0 error
+	}{R0: r0}
+	recv._Set.configured = true
+}
+
+func (recv *MockcCache) verify() {
+	recv.t.Helper()
+	recv._Del.mu.Lock()
+	if recv._Del.Required && !recv._Del.Called {
+		recv.t.Errorf("MockcCache.Del: required but never called")
+	}
+	for _, call := range recv._Del.unconfiguredCalls {
+		recv.t.Errorf("MockcCache.%s: called without configured behavior", call)
+	}
+	recv._Del.mu.Unlock()
+	recv._Get.mu.Lock()
+	if recv._Get.Required && !recv._Get.Called {
+		recv.t.Errorf("MockcCache.Get: required but never called")
+	}
+	for _, call := range recv._Get.unconfiguredCalls {
+		recv.t.Errorf("MockcCache.%s: called without configured behavior", call)
+	}
+	recv._Get.mu.Unlock()
+	recv._Range.mu.Lock()
+	if recv._Range.Required && !recv._Range.Called {
+		recv.t.Errorf("MockcCache.Range: required but never called")
+	}
+	for _, call := range recv._Range.unconfiguredCalls {
+		recv.t.Errorf("MockcCache.%s: called without configured behavior", call)
+	}
+	recv._Range.mu.Unlock()
+	recv._Set.mu.Lock()
+	if recv._Set.Required && !recv._Set.Called {
+		recv.t.Errorf("MockcCache.Set: required but never called")
+	}
+	for _, call := range recv._Set.unconfiguredCalls {
+		recv.t.Errorf("MockcCache.%s: called without configured behavior", call)
+	}
+	recv._Set.mu.Unlock()
+}
@@ -0,0 +1,30 @@
+package transcript
+
+import (
+	"errors"
+	"fmt"
+)
+
+type Inventory interface {
+	Reserve(sku string, quantity int) (err error)
+	Release(sku string, quantity int)
+}
+
+var ErrOutOfStock = errors.New("out of stock")
+
+// Checkout reserves the items of the cart, and releases the reserved items if any of them is out of stock.
+func Checkout(inv Inventory, cart map[string]int, skus ...string) error {
+	var reserved []string
+	for _, sku := range skus {
+		err := inv.Reserve(sku, cart[sku])
+		if err != nil {
+			for _, r := range reserved {
+				inv.Release(r, cart[r])
+			}
+			return fmt.Errorf("cannot reserve %s: %w", sku, err)
+		}
+		reserved = append(reserved, sku)
+	}
+
+	return nil
+}
@@ -0,0 +1,27 @@
+package transcript
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/KimMachineGun/mockc"
+)
+
+func TestCheckout_OutOfStock(t *testing.T) {
+	m := &MockcInventory{}
+	m._Reserve.ResultsSeq = append(m._Reserve.ResultsSeq,
+		struct{ R0 error }{},
+		struct{ R0 error }{},
+		struct{ R0 error }{R0: ErrOutOfStock},
+	)
+
+	// execute
+	err := Checkout(m, map[string]int{"apple": 3, "banana": 1, "cherry": 12}, "apple", "banana", "cherry")
+
+	// assert
+	if !errors.Is(err, ErrOutOfStock) {
+		t.Errorf("err should be %v: actual(%v)", ErrOutOfStock, err)
+	}
+	// run 'MOCKC_UPDATE=1 go test' to update the golden file
+	mockc.AssertGolden(t, "testdata/out_of_stock.golden", m)
+}
@@ -0,0 +1,12 @@
+//+build mockc
+
+package transcript
+
+import (
+	"github.com/KimMachineGun/mockc"
+)
+
+func MockcInventory() {
+	mockc.Implement(Inventory(nil))
+	mockc.WithTranscript()
+}
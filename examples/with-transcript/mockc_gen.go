@@ -0,0 +1,205 @@
+// Code generated by Mockc. DO NOT EDIT.
+// repo: https://github.com/KimMachineGun/mockc
+
+//go:generate mockc
+//go:build !mockc
+// +build !mockc
+
+package transcript
+
+import (
+	mockc "github.com/KimMachineGun/mockc"
+	"sync"
+)
+
+var _ interface {
+	Inventory
+} = &MockcInventory{}
+
+type MockcInventory struct {
+	// method: Release
+	_Release struct {
+		mu sync.Mutex
+		// basics
+		Called    bool
+		CallCount int
+		// call history
+		History []struct {
+			Params struct {
+				P0 string
+				P1 int
+			}
+			Seq uint64
+		}
+		// params
+		Params struct {
+			P0 string
+			P1 int
+		}
+		// if it is not nil, it'll be called in the middle of the method.
+		Body func(string, int)
+	}
+	// method: Reserve
+	_Reserve struct {
+		mu sync.Mutex
+		// basics
+		Called    bool
+		CallCount int
+		// call history
+		History []struct {
+			Params struct {
+				P0 string
+				P1 int
+			}
+			Results struct {
+				R0 error
+			}
+			Seq uint64
+		}
+		// params
+		Params struct {
+			P0 string
+			P1 int
+		}
+		// results
+		Results struct {
+			R0 error
+		}
+		// if it is not empty, its first element will be consumed instead of the results.
+		ResultsSeq []struct {
+			R0 error
+		}
+		// if it is not nil, it'll be called in the middle of the method.
+		Body func(string, int) error
+	}
+}
+
+func (recv *MockcInventory) Release(p0 string, p1 int) {
+	// call order
+	seq := mockc.NextSeq()
+	recv._Release.mu.Lock()
+	defer recv._Release.mu.Unlock()
+	// basics
+	recv._Release.Called = true
+	recv._Release.CallCount++
+	// params
+	recv._Release.Params.P0 = p0
+	recv._Release.Params.P1 = p1
+	// body
+	if recv._Release.Body != nil {
+		recv._Release.Body(p0, p1)
+	}
+	// call history
+	recv._Release.History = append(recv._Release.History, struct {
+		Params struct {
+			P0 string
+			P1 int
+		}
+		Seq uint64
+	}{
+		Params: recv._Release.Params,
+		Seq:    seq,
+	})
+}
+
+func (recv *MockcInventory) Reserve(p0 string, p1 int) error {
+	// call order
+	seq := mockc.NextSeq()
+	recv._Reserve.mu.Lock()
+	defer recv._Reserve.mu.Unlock()
+	// basics
+	recv._Reserve.Called = true
+	recv._Reserve.CallCount++
+	// params
+	recv._Reserve.Params.P0 = p0
+	recv._Reserve.Params.P1 = p1
+	// default results
+	results := recv._Reserve.Results
+	// results sequence
+	if recv._Reserve.Body == nil && len(recv._Reserve.ResultsSeq) > 0 {
+		results = recv._Reserve.ResultsSeq[0]
+		recv._Reserve.ResultsSeq = recv._Reserve.ResultsSeq[1:]
+	}
+	// body
+	if recv._Reserve.Body != nil {
+		results.R0 = recv._Reserve.Body(p0, p1)
+		recv._Reserve.Results = results
+	}
+	// call history
+	recv._Reserve.History = append(recv._Reserve.History, struct {
+		Params struct {
+			P0 string
+			P1 int
+		}
+		Results struct {
+			R0 error
+		}
+		Seq uint64
+	}{
+		Params:  recv._Reserve.Params,
+		Results: results,
+		Seq:     seq,
+	})
+	// results
+	return results.R0
+}
+
+type MockcInventoryCalls struct {
+	m *MockcInventory
+}
+
+func (recv *MockcInventory) Calls() MockcInventoryCalls {
+	return MockcInventoryCalls{m: recv}
+}
+
+func (c MockcInventoryCalls) Release(i int) mockc.Call {
+	c.m._Release.mu.Lock()
+	defer c.m._Release.mu.Unlock()
+	call := mockc.Call{
+		Index:  i,
+		Method: "Release",
+		Mock:   "MockcInventory",
+	}
+	if i >= 0 && i < len(c.m._Release.History) {
+		call.Seq = c.m._Release.History[i].Seq
+	}
+	return call
+}
+
+func (c MockcInventoryCalls) Reserve(i int) mockc.Call {
+	c.m._Reserve.mu.Lock()
+	defer c.m._Reserve.mu.Unlock()
+	call := mockc.Call{
+		Index:  i,
+		Method: "Reserve",
+		Mock:   "MockcInventory",
+	}
+	if i >= 0 && i < len(c.m._Reserve.History) {
+		call.Seq = c.m._Reserve.History[i].Seq
+	}
+	return call
+}
+
+func (recv *MockcInventory) Transcript() mockc.Transcript {
+	var calls []mockc.TranscriptCall
+	recv._Release.mu.Lock()
+	for _, h := range recv._Release.History {
+		calls = append(calls, mockc.TranscriptCall{
+			Method: "Release",
+			Params: []interface{}{h.Params.P0, h.Params.P1},
+			Seq:    h.Seq,
+		})
+	}
+	recv._Release.mu.Unlock()
+	recv._Reserve.mu.Lock()
+	for _, h := range recv._Reserve.History {
+		calls = append(calls, mockc.TranscriptCall{
+			Method:  "Reserve",
+			Params:  []interface{}{h.Params.P0, h.Params.P1},
+			Results: []interface{}{h.Results.R0},
+			Seq:     h.Seq,
+		})
+	}
+	recv._Reserve.mu.Unlock()
+	return mockc.NewTranscript("MockcInventory", calls)
+}
@@ -0,0 +1,13 @@
+//+build mockc
+
+package waiters
+
+import (
+	"github.com/KimMachineGun/mockc"
+)
+
+func MockcNotifier() {
+	mockc.Implement(Notifier(nil))
+	mockc.WithWaiters()
+	mockc.WithAccessors()
+}
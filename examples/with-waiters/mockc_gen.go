@@ -0,0 +1,182 @@
+// Code generated by Mockc. DO NOT EDIT.
+// repo: https://github.com/KimMachineGun/mockc
+
+//go:generate mockc
+//go:build !mockc
+// +build !mockc
+
+package waiters
+
+import (
+	"context"
+	"sync"
+)
+
+var _ interface {
+	Notifier
+} = &MockcNotifier{}
+
+type MockcNotifier struct {
+	// method: Notify
+	_Notify struct {
+		mu sync.Mutex
+		// basics
+		Called    bool
+		CallCount int
+		// it'll be closed on the next call.
+		notify chan struct{}
+		// call history
+		History []struct {
+			Params struct {
+				P0 string
+				P1 string
+			}
+			Results struct {
+				R0 error
+			}
+		}
+		// params
+		Params struct {
+			P0 string
+			P1 string
+		}
+		// results
+		Results struct {
+			R0 error
+		}
+		// if it is not empty, its first element will be consumed instead of the results.
+		ResultsSeq []struct {
+			R0 error
+		}
+		// if it is not nil, it'll be called in the middle of the method.
+		Body func(string, string) error
+	}
+}
+
+func (recv *MockcNotifier) Notify(p0 string, p1 string) error {
+	recv._Notify.mu.Lock()
+	defer recv._Notify.mu.Unlock()
+	// basics
+	recv._Notify.Called = true
+	recv._Notify.CallCount++
+	if recv._Notify.notify != nil {
+		close(recv._Notify.notify)
+		recv._Notify.notify = nil
+	}
+	// params
+	recv._Notify.Params.P0 = p0
+	recv._Notify.Params.P1 = p1
+	// default results
+	results := recv._Notify.Results
+	// results sequence
+	if recv._Notify.Body == nil && len(recv._Notify.ResultsSeq) > 0 {
+		results = recv._Notify.ResultsSeq[0]
+		recv._Notify.ResultsSeq = recv._Notify.ResultsSeq[1:]
+	}
+	// body
+	if recv._Notify.Body != nil {
+		results.R0 = recv._Notify.Body(p0, p1)
+		recv._Notify.Results = results
+	}
+	// call history
+	recv._Notify.History = append(recv._Notify.History, struct {
+		Params struct {
+			P0 string
+			P1 string
+		}
+		Results struct {
+			R0 error
+		}
+	}{
+		Params:  recv._Notify.Params,
+		Results: results,
+	})
+	// results
+	return results.R0
+}
+
+func (recv *MockcNotifier) NotifyCallCount() int {
+	recv._Notify.mu.Lock()
+	defer recv._Notify.mu.Unlock()
+	return recv._Notify.CallCount
+}
+
+func (recv *MockcNotifier) NotifyParams() struct {
+	P0 string
+	P1 string
+} {
+	recv._Notify.mu.Lock()
+	defer recv._Notify.mu.Unlock()
+	return recv._Notify.Params
+}
+
+func (recv *MockcNotifier) NotifyHistory() []struct {
+	Params struct {
+		P0 string
+		P1 string
+	}
+	Results struct {
+		R0 error
+	}
+} {
+	recv._Notify.mu.Lock()
+	defer recv._Notify.mu.Unlock()
+	return append(recv._Notify.History[:0:0], recv._Notify.History...)
+}
+
+func (recv *MockcNotifier) SetNotifyResults(r0 error) {
+	recv._Notify.mu.Lock()
+	defer recv._Notify.mu.Unlock()
+	recv._Notify.Results = struct {
+		R0 error
+	}{R0: r0}
+}
+
+func (recv *MockcNotifier) AppendNotifyResults(r0 error) {
+	recv._Notify.mu.Lock()
+	defer recv._Notify.mu.Unlock()
+	recv._Notify.ResultsSeq = append(recv._Notify.ResultsSeq, struct {
+		R0 error
+	}{R0: r0})
+}
+
+func (recv *MockcNotifier) SetNotifyBody(body func(string, string) error) {
+	recv._Notify.mu.Lock()
+	defer recv._Notify.mu.Unlock()
+	recv._Notify.Body = body
+}
+
+func (recv *MockcNotifier) WaitNotify(ctx context.Context, n int) error {
+	for {
+		recv._Notify.mu.Lock()
+		if recv._Notify.CallCount >= n {
+			recv._Notify.mu.Unlock()
+			return nil
+		}
+		if recv._Notify.notify == nil {
+			recv._Notify.notify = make(chan struct{})
+		}
+		notify := recv._Notify.notify
+		recv._Notify.mu.Unlock()
+
+		select {
+		case <-notify:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (recv *MockcNotifier) NotifyCalled() <-chan struct{} {
+	recv._Notify.mu.Lock()
+	defer recv._Notify.mu.Unlock()
+	if recv._Notify.CallCount > 0 {
+		called := make(chan struct{})
+		close(called)
+		return called
+	}
+	if recv._Notify.notify == nil {
+		recv._Notify.notify = make(chan struct{})
+	}
+	return recv._Notify.notify
+}
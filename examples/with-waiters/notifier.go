@@ -0,0 +1,14 @@
+package waiters
+
+type Notifier interface {
+	Notify(userID string, message string) (err error)
+}
+
+type Service struct {
+	Notifier Notifier
+}
+
+// SignUp notifies the user in the background, and returns without waiting for it.
+func (s Service) SignUp(userID string) {
+	go s.Notifier.Notify(userID, "welcome")
+}
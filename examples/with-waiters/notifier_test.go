@@ -0,0 +1,52 @@
+package waiters
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestService_SignUp(t *testing.T) {
+	m := &MockcNotifier{}
+	s := Service{
+		Notifier: m,
+	}
+
+	// execute
+	s.SignUp("foo")
+	s.SignUp("bar")
+
+	// wait for the background calls
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	err := m.WaitNotify(ctx, 2)
+
+	// assert
+	if err != nil {
+		t.Fatalf("Notifier.Notify should be called twice: %v", err)
+	}
+	for _, call := range m.NotifyHistory() {
+		if call.Params.P1 != "welcome" {
+			t.Errorf("Notifier.Notify should be called with %q: actual(%q)", "welcome", call.Params.P1)
+		}
+	}
+}
+
+func TestService_SignUp_Called(t *testing.T) {
+	m := &MockcNotifier{}
+	s := Service{
+		Notifier: m,
+	}
+
+	// execute
+	called := m.NotifyCalled()
+	s.SignUp("foo")
+
+	// assert
+	select {
+	case <-called:
+	case <-time.After(time.Second):
+		t.Fatal("Notifier.Notify should be called")
+	}
+}
@@ -0,0 +1,276 @@
+package mockc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// RecordEnv is the environment variable that makes the mocks generated with WithRecordReplay record the fixtures
+// in UseFixture when it is set to a true value like 1.
+const RecordEnv = "MOCKC_RECORD"
+
+// RecordingFixtures reports whether the RecordEnv environment variable is set to a true value.
+func RecordingFixtures() bool {
+	v, _ := strconv.ParseBool(os.Getenv(RecordEnv))
+	return v
+}
+
+// Fixture records the method calls of the mock generated with WithRecordReplay into the file,
+// or serves the results of the method calls recorded in the file.
+// The params and the results are encoded as JSON, and the errors are replayed as the errors with the same messages.
+// The params that can't be encoded, such as contexts, functions and channels, are ignored on matching the calls.
+type Fixture struct {
+	t         TB
+	mock      string
+	path      string
+	recording bool
+
+	mu    sync.Mutex
+	calls []fixtureCall
+	used  []bool
+}
+
+type fixtureCall struct {
+	Method  string            `json:"method"`
+	Params  []json.RawMessage `json:"params,omitempty"`
+	Results []json.RawMessage `json:"results,omitempty"`
+}
+
+type fixtureFile struct {
+	Calls []fixtureCall `json:"calls"`
+}
+
+type fixtureError struct {
+	Error string `json:"error"`
+}
+
+// NewFixture returns the fixture of the mock.
+// If recording is true, the fixture records the method calls, and writes them to the file when the test finishes.
+// Otherwise, it reads the method calls recorded in the file, and fails the test if the file cannot be read
+// or if any of the recorded calls has not been replayed when the test finishes.
+func NewFixture(t TB, mock string, path string, recording bool) *Fixture {
+	t.Helper()
+
+	f := &Fixture{
+		t:         t,
+		mock:      mock,
+		path:      path,
+		recording: recording,
+	}
+
+	if recording {
+		t.Cleanup(f.save)
+		return f
+	}
+
+	b, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		t.Fatalf("mockc: the fixture %s does not exist: run the test with MOCKC_RECORD=1 to record it", path)
+	} else if err != nil {
+		t.Fatalf("mockc: cannot read the fixture %s: %v", path, err)
+	}
+
+	var file fixtureFile
+	err = json.Unmarshal(b, &file)
+	if err != nil {
+		t.Fatalf("mockc: cannot parse the fixture %s: %v", path, err)
+	}
+	for _, call := range file.Calls {
+		for i, param := range call.Params {
+			call.Params[i] = compact(param)
+		}
+	}
+
+	f.calls = file.Calls
+	f.used = make([]bool, len(file.Calls))
+	t.Cleanup(f.checkUsed)
+
+	return f
+}
+
+// Recording reports whether the fixture records the method calls.
+func (f *Fixture) Recording() bool {
+	return f.recording
+}
+
+// Record records the method call with its params and results.
+func (f *Fixture) Record(method string, params []interface{}, results []interface{}) {
+	f.t.Helper()
+
+	call := fixtureCall{
+		Method: method,
+		Params: f.encodeParams(method, params, "record"),
+	}
+	for i, result := range results {
+		raw, err := encodeValue(result)
+		if err != nil {
+			f.t.Errorf("mockc: %s.%s: cannot record the result %d: %v", f.mock, method, i, err)
+			raw = json.RawMessage("null")
+		}
+		call.Results = append(call.Results, raw)
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.calls = append(f.calls, call)
+}
+
+// Replay finds the first recorded call of the method that has not been replayed and has the same params,
+// and decodes its results into the pointers of the results.
+// If there's no such call, it fails the test and leaves the results as they are.
+func (f *Fixture) Replay(method string, params []interface{}, results ...interface{}) {
+	f.t.Helper()
+
+	encoded := f.encodeParams(method, params, "replay")
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for i, call := range f.calls {
+		if f.used[i] || call.Method != method || !equalParams(call.Params, encoded) {
+			continue
+		}
+		f.used[i] = true
+
+		for j, result := range results {
+			raw := json.RawMessage("null")
+			if j < len(call.Results) {
+				raw = call.Results[j]
+			}
+
+			err := decodeValue(raw, result)
+			if err != nil {
+				f.t.Errorf("mockc: %s.%s: cannot replay the result %d: %v", f.mock, method, j, err)
+			}
+		}
+		return
+	}
+
+	f.t.Errorf("mockc: %s.%s(%s): no recorded call matches", f.mock, method, joinParams(encoded))
+}
+
+// checkUsed fails the test if any of the recorded calls has not been replayed.
+func (f *Fixture) checkUsed() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for i, call := range f.calls {
+		if !f.used[i] {
+			f.t.Errorf("mockc: %s.%s(%s): recorded in %s but not replayed", f.mock, call.Method, joinParams(call.Params), f.path)
+		}
+	}
+}
+
+func (f *Fixture) save() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	b, err := json.MarshalIndent(fixtureFile{Calls: f.calls}, "", "  ")
+	if err == nil {
+		err = os.MkdirAll(filepath.Dir(f.path), 0777)
+	}
+	if err == nil {
+		err = ioutil.WriteFile(f.path, append(b, '\n'), 0666)
+	}
+	if err != nil {
+		f.t.Errorf("mockc: cannot write the fixture %s: %v", f.path, err)
+	}
+}
+
+// encodeParams encodes the params of the method call, and fails the test if any of them cannot be encoded.
+// The params that cannot be encoded are encoded as null, which can't tell the calls apart.
+func (f *Fixture) encodeParams(method string, params []interface{}, action string) []json.RawMessage {
+	f.t.Helper()
+
+	encoded := make([]json.RawMessage, len(params))
+	for i, param := range params {
+		raw, err := encodeValue(param)
+		if err != nil {
+			f.t.Errorf("mockc: %s.%s: cannot %s the param %d: %v", f.mock, method, action, i, err)
+			raw = json.RawMessage("null")
+		}
+		encoded[i] = raw
+	}
+
+	return encoded
+}
+
+func joinParams(params []json.RawMessage) string {
+	args := make([]string, len(params))
+	for i, param := range params {
+		args[i] = string(param)
+	}
+
+	return strings.Join(args, ", ")
+}
+
+func encodeValue(v interface{}) (json.RawMessage, error) {
+	switch v := v.(type) {
+	case nil, context.Context:
+		return json.RawMessage("null"), nil
+	case error:
+		return json.Marshal(fixtureError{Error: v.Error()})
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Func, reflect.Chan, reflect.UnsafePointer:
+		return json.RawMessage("null"), nil
+	}
+
+	return json.Marshal(v)
+}
+
+func decodeValue(raw json.RawMessage, ptr interface{}) error {
+	rv := reflect.ValueOf(ptr).Elem()
+	rv.Set(reflect.Zero(rv.Type()))
+
+	if bytes.Equal(compact(raw), []byte("null")) {
+		return nil
+	}
+
+	if errPtr, ok := ptr.(*error); ok {
+		var e fixtureError
+		err := json.Unmarshal(raw, &e)
+		if err != nil {
+			return err
+		}
+		*errPtr = errors.New(e.Error)
+		return nil
+	}
+
+	return json.Unmarshal(raw, ptr)
+}
+
+func equalParams(a, b []json.RawMessage) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !bytes.Equal(a[i], b[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func compact(raw json.RawMessage) json.RawMessage {
+	var buf bytes.Buffer
+	err := json.Compact(&buf, raw)
+	if err != nil {
+		return raw
+	}
+
+	return buf.Bytes()
+}
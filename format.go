@@ -0,0 +1,53 @@
+package mockc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// FormatCall formats the method call like Get("key") for the failure messages of the generated mocks.
+// The params are formatted in the same way as the Transcript.
+func FormatCall(method string, params ...interface{}) string {
+	return method + "(" + formatValues(params) + ")"
+}
+
+func formatValues(vs []interface{}) string {
+	ss := make([]string, len(vs))
+	for i, v := range vs {
+		ss[i] = FormatValue(v)
+	}
+
+	return strings.Join(ss, ", ")
+}
+
+// FormatValue formats the value for the descriptions of the expectations of the generated mocks.
+// Nil, errors, contexts, functions and channels are formatted specially, and the others are formatted as JSON.
+func FormatValue(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return "nil"
+	case context.Context:
+		return "ctx"
+	case error:
+		return fmt.Sprintf("error(%q)", v.Error())
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Func, reflect.Chan, reflect.UnsafePointer:
+		if rv.IsNil() {
+			return "nil"
+		}
+		return fmt.Sprintf("<%T>", v)
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("<%T>", v)
+	}
+
+	return string(b)
+}
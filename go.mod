@@ -1,9 +1,17 @@
 module github.com/KimMachineGun/mockc
 
-go 1.13
+go 1.25.0
 
 require (
-	github.com/dave/jennifer v1.4.1
+	github.com/dave/jennifer v1.7.1
 	github.com/stretchr/testify v1.7.0
-	golang.org/x/tools v0.19.0
+	golang.org/x/tools v0.44.0
+	gopkg.in/yaml.v3 v3.0.1
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.0 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	golang.org/x/mod v0.35.0 // indirect
+	golang.org/x/sync v0.21.0 // indirect
 )
@@ -0,0 +1,199 @@
+package mockc
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"go/types"
+	"hash"
+	"io"
+	"io/ioutil"
+	"os"
+	"sync"
+)
+
+// cacheVersion should be increased when the format of the cache key is changed.
+const cacheVersion = 3
+
+// cache keeps the method set hashes of the generated mocks and the hashes of their files.
+// If both of them have not been changed since the last generation, the generator doesn't have to be rendered.
+type cache struct {
+	path string
+
+	mu      sync.Mutex
+	Entries map[string]cacheEntry `json:"entries"`
+}
+
+type cacheEntry struct {
+	Key string `json:"key"`
+	Sum string `json:"sum"`
+}
+
+func loadCache(path string) (*cache, error) {
+	c := &cache{
+		path:    path,
+		Entries: map[string]cacheEntry{},
+	}
+
+	b, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return c, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("cannot read cache: %v", err)
+	}
+
+	err = json.Unmarshal(b, c)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse cache %s: %v", path, err)
+	}
+	if c.Entries == nil {
+		c.Entries = map[string]cacheEntry{}
+	}
+
+	return c, nil
+}
+
+// hit reports whether the destination has been generated with the key, and has not been changed since then.
+func (c *cache) hit(destination string, key string) bool {
+	if c == nil {
+		return false
+	}
+
+	c.mu.Lock()
+	entry, ok := c.Entries[destination]
+	c.mu.Unlock()
+	if !ok || entry.Key != key {
+		return false
+	}
+
+	b, err := ioutil.ReadFile(destination)
+	if err != nil {
+		return false
+	}
+
+	return entry.Sum == sum(b)
+}
+
+func (c *cache) update(destination string, key string, b []byte) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.Entries[destination] = cacheEntry{
+		Key: key,
+		Sum: sum(b),
+	}
+}
+
+func (c *cache) save() error {
+	if c == nil {
+		return nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// the entries of the deleted destinations would never be hit again.
+	for destination := range c.Entries {
+		if _, err := os.Stat(destination); os.IsNotExist(err) {
+			delete(c.Entries, destination)
+		}
+	}
+
+	b, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return fmt.Errorf("cannot marshal cache: %v", err)
+	}
+
+	err = ioutil.WriteFile(c.path, append(b, '\n'), 0666)
+	if err != nil {
+		return fmt.Errorf("cannot write cache: %v", err)
+	}
+
+	return nil
+}
+
+func sum(b []byte) string {
+	h := sha256.Sum256(b)
+	return hex.EncodeToString(h[:])
+}
+
+// cacheKey returns the hash of everything that the rendered mocks depend on:
+// the build of mockc, the destination, and the type-checked method sets and options of the mocks.
+func (g *generator) cacheKey(gogenerate string) string {
+	g.sortMocks()
+	g.sortInterfaces()
+
+	h := sha256.New()
+	fmt.Fprintln(h, cacheVersion, buildID())
+	fmt.Fprintln(h, g.pkg.PkgPath, g.pkg.Name, g.path, gogenerate)
+
+	qualifier := func(pkg *types.Package) string {
+		return pkg.Path()
+	}
+	for _, mock := range g.mocks {
+		// the field name formatter is hashed by its output, since functions cannot be printed
+		opts := mock.mockOptions
+		opts.fieldNameFormatter = nil
+		fmt.Fprintf(h, "%s %s %+v\n", mock.name, mock.fieldNameFormatter("\x00"), opts)
+		writeTypeParams(h, mock.typeParams, qualifier)
+		writeMethodSet(h, mock.typ, qualifier)
+		if mock.funcType != nil {
+			fmt.Fprintln(h, types.TypeString(mock.funcType, qualifier), types.TypeString(mock.funcType.Underlying(), qualifier))
+		}
+	}
+	for _, inter := range g.interfaces {
+		fmt.Fprintln(h, inter.name)
+		writeMethodSet(h, inter.typ, qualifier)
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// writeMethodSet writes the full method set of the interface,
+// since the type string shows the embedded interfaces only by their names.
+func writeMethodSet(h hash.Hash, iface *types.Interface, qualifier types.Qualifier) {
+	for i := 0; i < iface.NumMethods(); i++ {
+		method := iface.Method(i)
+		fmt.Fprintln(h, method.Name(), types.TypeString(method.Type(), qualifier))
+	}
+}
+
+func writeTypeParams(h hash.Hash, typeParams *types.TypeParamList, qualifier types.Qualifier) {
+	if typeParams == nil {
+		return
+	}
+
+	for i := 0; i < typeParams.Len(); i++ {
+		typeParam := typeParams.At(i)
+		fmt.Fprintln(h, typeParam.Obj().Name(), types.TypeString(typeParam.Constraint(), qualifier))
+	}
+}
+
+// buildID identifies the build of mockc by the hash of its executable,
+// so that the cache is invalidated whenever mockc is rebuilt, even from a modified working tree.
+// It returns an empty string if the executable cannot be read, and the cache is disabled in that case.
+var buildID = sync.OnceValue(func() string {
+	path, err := os.Executable()
+	if err != nil {
+		return ""
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return ""
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	_, err = io.Copy(h, f)
+	if err != nil {
+		return ""
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+})
@@ -0,0 +1,101 @@
+package mockc
+
+import (
+	"bytes"
+	"context"
+	"go/types"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"unsafe"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/tools/go/packages"
+)
+
+func TestCacheSave(t *testing.T) {
+	a := assert.New(t)
+
+	dir := t.TempDir()
+	existing := filepath.Join(dir, "mockc_gen.go")
+	deleted := filepath.Join(dir, "deleted_gen.go")
+	a.NoError(ioutil.WriteFile(existing, []byte("package basic\n"), 0666))
+
+	c, err := loadCache(filepath.Join(dir, "cache.json"))
+	a.NoError(err)
+	c.update(existing, "key", []byte("package basic\n"))
+	c.update(deleted, "key", []byte("package basic\n"))
+	a.NoError(c.save())
+
+	c, err = loadCache(filepath.Join(dir, "cache.json"))
+	a.NoError(err)
+	a.Contains(c.Entries, existing)
+	a.NotContains(c.Entries, deleted)
+	a.True(c.hit(existing, "key"))
+}
+
+func TestCacheKeyOptions(t *testing.T) {
+	a := assert.New(t)
+
+	key := func(opts mockOptions) string {
+		g := newGenerator(&packages.Package{PkgPath: "example.com/basic", Name: "basic"}, "mockc_gen.go")
+		g.mocks = append(g.mocks, mockInfo{
+			mockOptions: opts,
+			typ:         types.NewInterfaceType(nil, nil).Complete(),
+			name:        "MockcCache",
+		})
+		return g.cacheKey("")
+	}
+
+	opts := mockOptions{fieldNameFormatter: newFieldNameFormatter("_", "")}
+	base := key(opts)
+	a.Equal(base, key(opts))
+
+	v := reflect.ValueOf(&opts).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Field(i)
+		if field.Kind() != reflect.Bool {
+			continue
+		}
+		// the options are unexported, so they are set through their addresses
+		o := opts
+		f := reflect.ValueOf(&o).Elem().Field(i)
+		reflect.NewAt(f.Type(), unsafe.Pointer(f.UnsafeAddr())).Elem().SetBool(true)
+		a.NotEqual(base, key(o), v.Type().Field(i).Name)
+	}
+
+	a.NotEqual(base, key(mockOptions{fieldNameFormatter: newFieldNameFormatter("", "_")}))
+}
+
+func TestCacheKeyEmbeddedInterface(t *testing.T) {
+	a := assert.New(t)
+
+	buf := bytes.NewBuffer(nil)
+	log.SetFlags(0)
+	log.SetOutput(buf)
+
+	dir := filepath.Join(testRoot, "cache")
+	src := filepath.Join(dir, "cache.go")
+	original, err := ioutil.ReadFile(src)
+	a.NoError(err)
+	t.Cleanup(func() {
+		a.NoError(ioutil.WriteFile(src, original, 0666))
+		a.NoError(os.Remove(filepath.Join(dir, defaultDestination)))
+	})
+
+	opts := Options{Cache: filepath.Join(t.TempDir(), "cache.json")}
+	a.NoError(Generate(context.Background(), dir, nil, opts))
+
+	// the mock embeds Cache, so the type string of the mock doesn't change with the methods of Cache.
+	modified := bytes.Replace(original, []byte("\tDel(key string) (err error)\n"), []byte("\tDel(key string) (err error)\n\tHas(key string) bool\n"), 1)
+	a.NoError(ioutil.WriteFile(src, modified, 0666))
+	a.NoError(Generate(context.Background(), dir, nil, opts))
+
+	a.Regexp(`^generated: /(.+?)/testdata/cache/mockc_gen\.go\ngenerated: /(.+?)/testdata/cache/mockc_gen\.go\n$`, buf.String())
+	b, err := ioutil.ReadFile(filepath.Join(dir, defaultDestination))
+	a.NoError(err)
+	a.Contains(string(b), "func (recv *MockcCache) Has(")
+}
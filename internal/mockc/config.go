@@ -0,0 +1,171 @@
+package mockc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// config is the project-wide configuration file that describes many mocks.
+type config struct {
+	Mocks []mockConfig `yaml:"mocks" json:"mocks"`
+}
+
+type mockConfig struct {
+	Name             string   `yaml:"name" json:"name"`
+	Destination      string   `yaml:"destination" json:"destination"`
+	Package          string   `yaml:"package" json:"package"`
+	Interfaces       []string `yaml:"interfaces" json:"interfaces"`
+	WithConstructor  bool     `yaml:"withConstructor" json:"withConstructor"`
+	FieldNamePrefix  *string  `yaml:"fieldNamePrefix" json:"fieldNamePrefix"`
+	FieldNameSuffix  *string  `yaml:"fieldNameSuffix" json:"fieldNameSuffix"`
+	ParamNames       bool     `yaml:"paramNames" json:"paramNames"`
+	WithExpectations bool     `yaml:"withExpectations" json:"withExpectations"`
+	WithTestingT     bool     `yaml:"withTestingT" json:"withTestingT"`
+	Strict           bool     `yaml:"strict" json:"strict"`
+	WithCallOrder    bool     `yaml:"withCallOrder" json:"withCallOrder"`
+	Spy              bool     `yaml:"spy" json:"spy"`
+	WithMatchers     bool     `yaml:"withMatchers" json:"withMatchers"`
+	WithAccessors    bool     `yaml:"withAccessors" json:"withAccessors"`
+	HideFields       bool     `yaml:"hideFields" json:"hideFields"`
+	WithWaiters      bool     `yaml:"withWaiters" json:"withWaiters"`
+	WithReset        bool     `yaml:"withReset" json:"withReset"`
+	WithFailures     bool     `yaml:"withFailures" json:"withFailures"`
+	WithLatency      bool     `yaml:"withLatency" json:"withLatency"`
+	WithTranscript   bool     `yaml:"withTranscript" json:"withTranscript"`
+	WithRecordReplay bool     `yaml:"withRecordReplay" json:"withRecordReplay"`
+}
+
+// GenerateWithConfig generates all the mocks described in the configuration file with a single package load.
+// The relative destinations in the configuration file are resolved against the file's directory.
+// The go:generate directive that reruns the configuration is written only into the destination of the first mock.
+func GenerateWithConfig(ctx context.Context, wd string, path string, opts Options) error {
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(wd, path)
+	}
+
+	mocks, err := loadConfig(path)
+	if err != nil {
+		return err
+	}
+
+	configDir := filepath.Dir(path)
+
+	// the go:generate directive is emitted only into the file of the first mock, so that go generate runs the configuration once.
+	first := mocks[0]
+
+	return generateWithFlags(ctx, configDir, mocks, opts, func(destination string, mocks []MockFlags) string {
+		if mocks[0].Name != first.Name || mocks[0].Destination != first.Destination {
+			return ""
+		}
+
+		rel, err := filepath.Rel(filepath.Dir(destination), path)
+		if err != nil || destination == stdoutDestination {
+			rel = filepath.Base(path)
+		}
+
+		return fmt.Sprintf("mockc \"-config=%s\"", filepath.ToSlash(rel))
+	})
+}
+
+func loadConfig(path string) ([]MockFlags, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read config: %v", err)
+	}
+
+	var c config
+	switch ext := filepath.Ext(path); ext {
+	case ".yaml", ".yml":
+		dec := yaml.NewDecoder(bytes.NewReader(b))
+		dec.KnownFields(true)
+		err = dec.Decode(&c)
+	case ".json":
+		dec := json.NewDecoder(bytes.NewReader(b))
+		dec.DisallowUnknownFields()
+		err = dec.Decode(&c)
+	default:
+		return nil, fmt.Errorf("config should be a yaml or json file: %s", filepath.Base(path))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse config: %v", err)
+	}
+
+	if len(c.Mocks) == 0 {
+		return nil, errors.New("invalid config:\n\tno mocks are described")
+	}
+
+	configDir := filepath.Dir(path)
+
+	mocks := make([]MockFlags, 0, len(c.Mocks))
+	for i, m := range c.Mocks {
+		flags, err := m.mockFlags(configDir)
+		if err != nil {
+			errorMessage := "invalid config:"
+			errorMessage += fmt.Sprintf("\n\tmocks[%d]: %v", i, err)
+
+			return nil, errors.New(errorMessage)
+		}
+
+		mocks = append(mocks, flags)
+	}
+
+	return mocks, nil
+}
+
+func (m mockConfig) mockFlags(configDir string) (MockFlags, error) {
+	if m.Name == "" {
+		return MockFlags{}, errors.New("name is required")
+	}
+	if m.Destination == "" {
+		return MockFlags{}, fmt.Errorf("mock %q: destination is required", m.Name)
+	}
+	if len(m.Interfaces) == 0 {
+		return MockFlags{}, fmt.Errorf("mock %q: at least one interface is required", m.Name)
+	}
+
+	flags := MockFlags{
+		Destination:      m.Destination,
+		Package:          m.Package,
+		Name:             m.Name,
+		WithConstructor:  m.WithConstructor,
+		FieldNamePrefix:  defaultFieldNamePrefix,
+		FieldNameSuffix:  defaultFieldNameSuffix,
+		ParamNames:       m.ParamNames,
+		WithExpectations: m.WithExpectations,
+		WithTestingT:     m.WithTestingT,
+		Strict:           m.Strict,
+		WithCallOrder:    m.WithCallOrder,
+		Spy:              m.Spy,
+		WithMatchers:     m.WithMatchers,
+		WithAccessors:    m.WithAccessors,
+		HideFields:       m.HideFields,
+		WithWaiters:      m.WithWaiters,
+		WithReset:        m.WithReset,
+		WithFailures:     m.WithFailures,
+		WithLatency:      m.WithLatency,
+		WithTranscript:   m.WithTranscript,
+		WithRecordReplay: m.WithRecordReplay,
+		Interfaces:       m.Interfaces,
+	}
+	if m.FieldNamePrefix != nil {
+		flags.FieldNamePrefix = *m.FieldNamePrefix
+	}
+	if m.FieldNameSuffix != nil {
+		flags.FieldNameSuffix = *m.FieldNameSuffix
+	}
+	if flags.FieldNamePrefix == "" && flags.FieldNameSuffix == "" {
+		return MockFlags{}, fmt.Errorf("mock %q: at least one of the fieldNamePrefix and fieldNameSuffix must not be an empty string", m.Name)
+	}
+	if flags.Destination != stdoutDestination && !filepath.IsAbs(flags.Destination) {
+		flags.Destination = filepath.Join(configDir, flags.Destination)
+	}
+
+	return flags, nil
+}
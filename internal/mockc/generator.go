@@ -1,15 +1,21 @@
 package mockc
 
 import (
-	"context"
+	"bytes"
 	"errors"
 	"fmt"
 	"go/ast"
+	"go/token"
 	"go/types"
+	"io"
 	"io/ioutil"
 	"log"
+	"os"
+	"regexp"
 	"sort"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 
 	"golang.org/x/tools/go/packages"
 )
@@ -20,6 +26,8 @@ type generator struct {
 	imports         map[string]string
 	importConflicts map[string]int
 	mocks           []mockInfo
+	// interfaces are written instead of the mocks, if the generator is for the mockc_interface_gen.go.
+	interfaces []interfaceInfo
 }
 
 func newGenerator(pkg *packages.Package, path string) *generator {
@@ -31,16 +39,40 @@ func newGenerator(pkg *packages.Package, path string) *generator {
 	}
 }
 
-func (g *generator) Generate(gogenerate string) error {
+// Render renders the mocks of the generator.
+// It returns nil if the generator has no mocks.
+func (g *generator) Render(gogenerate string) ([]byte, error) {
+	if len(g.interfaces) > 0 {
+		g.sortInterfaces()
+
+		b, err := renderInterfaces(g.pkg, g.interfaces)
+		if err != nil {
+			return nil, fmt.Errorf("cannot execute template: %v", err)
+		}
+
+		return b, nil
+	}
 	if len(g.mocks) == 0 {
-		return nil
+		return nil, nil
 	}
 
 	g.sortMocks()
 
 	b, err := render(g.pkg, g.mocks, gogenerate)
 	if err != nil {
-		return fmt.Errorf("cannot execute template: %v", err)
+		return nil, fmt.Errorf("cannot execute template: %v", err)
+	}
+
+	return b, nil
+}
+
+// Generate writes the rendered mocks to the file.
+// If the file already has the same contents, it will not be written to keep its modification time.
+func (g *generator) Generate(b []byte) error {
+	actual, err := ioutil.ReadFile(g.path)
+	if err == nil && bytes.Equal(actual, b) {
+		log.Println("unchanged:", g.path)
+		return nil
 	}
 
 	err = ioutil.WriteFile(g.path, b, 0666)
@@ -53,6 +85,44 @@ func (g *generator) Generate(gogenerate string) error {
 	return nil
 }
 
+// Check compares the rendered mocks with the file on the disk without writing it.
+// It logs the unified diff and returns false if the file is out of date.
+func (g *generator) Check(b []byte) (bool, error) {
+	actual, err := ioutil.ReadFile(g.path)
+	if err != nil && !os.IsNotExist(err) {
+		return false, fmt.Errorf("cannot read %s: %v", g.path, err)
+	}
+
+	diff := unifiedDiff(g.path, g.path+" (generated)", actual, b)
+	if diff == "" {
+		log.Println("up to date:", g.path)
+		return true, nil
+	}
+
+	log.Printf("out of date: %s\n%s", g.path, diff)
+
+	return false, nil
+}
+
+// Print writes the rendered mocks to w instead of the file.
+func (g *generator) Print(w io.Writer, b []byte) error {
+	// the files printed in dry-run mode are separated by their paths, since more than one file can be printed.
+	if g.path != stdoutDestination {
+		b = append([]byte(fmt.Sprintf("// file: %s\n", g.path)), b...)
+	}
+
+	_, err := w.Write(b)
+	if err != nil {
+		return fmt.Errorf("cannot print %s: %v", g.path, err)
+	}
+
+	if g.path != stdoutDestination {
+		log.Println("dry run:", g.path)
+	}
+
+	return nil
+}
+
 func (g *generator) sortMocks() {
 	sort.Slice(g.mocks, func(i, j int) bool {
 		return g.mocks[i].name < g.mocks[j].name
@@ -64,44 +134,54 @@ func (g *generator) sortMocks() {
 	}
 }
 
-func (g *generator) addMockWithFlags(ctx context.Context, wd string, name string, withConstructor bool, fieldNamePrefix string, fieldNameSuffix string, interfacePatterns []string) error {
-	targetInterfaces := map[string][]string{}
-	for _, inter := range interfacePatterns {
-		idx := strings.LastIndex(inter, ".")
-		if idx == -1 {
-			errorMessage := "invalid interface pattern:"
-			errorMessage += fmt.Sprintf("\n\texpected interface pattern {package_path}.{interface_name}: actual %s", inter)
+func (g *generator) sortInterfaces() {
+	sort.Slice(g.interfaces, func(i, j int) bool {
+		return g.interfaces[i].name < g.interfaces[j].name
+	})
+}
 
-			return errors.New(errorMessage)
-		}
+func parseInterfacePattern(inter string) (pkgPath string, interfaceName string, err error) {
+	idx := strings.LastIndex(inter, ".")
+	if idx == -1 {
+		errorMessage := "invalid interface pattern:"
+		errorMessage += fmt.Sprintf("\n\texpected interface pattern {package_path}.{interface_name}: actual %s", inter)
 
-		pkgPath, interfaceName := inter[:idx], inter[idx+1:]
-		if pkgPath == "" || interfaceName == "" {
-			errorMessage := "invalid interface pattern:"
-			errorMessage += fmt.Sprintf("\n\texpected interface pattern {package-path}.{interface-name}: actual %s", inter)
+		return "", "", errors.New(errorMessage)
+	}
 
-			return errors.New(errorMessage)
-		}
+	pkgPath, interfaceName = inter[:idx], inter[idx+1:]
+	if pkgPath == "" || interfaceName == "" {
+		errorMessage := "invalid interface pattern:"
+		errorMessage += fmt.Sprintf("\n\texpected interface pattern {package-path}.{interface-name}: actual %s", inter)
 
-		targetInterfaces[pkgPath] = append(targetInterfaces[pkgPath], interfaceName)
+		return "", "", errors.New(errorMessage)
 	}
 
-	patterns := make([]string, 0, len(targetInterfaces))
-	for pkgPath := range targetInterfaces {
-		patterns = append(patterns, pkgPath)
-	}
+	return pkgPath, interfaceName, nil
+}
 
-	pkgs, err := loadPackages(ctx, wd, patterns)
-	if err != nil {
-		return fmt.Errorf("cannot load packages: %v", err)
+func (g *generator) addMockWithFlags(pkgs map[string]*packages.Package, flags MockFlags) error {
+	var pkgPaths []string
+	targetInterfaces := map[string][]string{}
+	for _, inter := range flags.Interfaces {
+		pkgPath, interfaceName, err := parseInterfacePattern(inter)
+		if err != nil {
+			return err
+		}
+
+		if _, ok := targetInterfaces[pkgPath]; !ok {
+			pkgPaths = append(pkgPaths, pkgPath)
+		}
+		targetInterfaces[pkgPath] = append(targetInterfaces[pkgPath], interfaceName)
 	}
 
-	interfaces := make([]types.Type, 0, len(interfacePatterns))
-	for _, pkg := range pkgs {
-		interfaceNames := targetInterfaces[pkg.PkgPath]
-		if len(interfaceNames) == 0 {
-			continue
+	interfaces := make([]types.Type, 0, len(flags.Interfaces))
+	for _, pkgPath := range pkgPaths {
+		pkg, ok := pkgs[pkgPath]
+		if !ok {
+			return fmt.Errorf("package %q: cannot load package", pkgPath)
 		}
+		interfaceNames := targetInterfaces[pkgPath]
 
 		f := newInterfaceFinder(pkg, interfaceNames)
 		for _, syntax := range pkg.Syntax {
@@ -112,18 +192,37 @@ func (g *generator) addMockWithFlags(ctx context.Context, wd string, name string
 			inter, ok := f.result[interfaceName]
 			if !ok {
 				return fmt.Errorf("package %q: cannot load interface: %s", pkg.PkgPath, interfaceName)
+			} else if f.generic[interfaceName] {
+				return fmt.Errorf("package %q: generic interface is not supported in command line flags mode: %s", pkg.PkgPath, interfaceName)
 			}
 
 			interfaces = append(interfaces, inter)
 		}
 	}
 
-	var constructor string
-	if withConstructor {
-		constructor = "New" + name
+	opts := mockOptions{
+		fieldNameFormatter: newFieldNameFormatter(flags.FieldNamePrefix, flags.FieldNameSuffix),
+		paramNames:         flags.ParamNames,
+		withExpectations:   flags.WithExpectations,
+		withTestingT:       flags.WithTestingT,
+		strict:             flags.Strict,
+		withCallOrder:      flags.WithCallOrder || flags.WithTranscript,
+		spy:                flags.Spy || flags.WithRecordReplay,
+		withMatchers:       flags.WithMatchers,
+		withAccessors:      flags.WithAccessors,
+		hideFields:         flags.HideFields,
+		withWaiters:        flags.WithWaiters,
+		withReset:          flags.WithReset,
+		withFailures:       flags.WithFailures,
+		withLatency:        flags.WithLatency,
+		withTranscript:     flags.WithTranscript,
+		withRecordReplay:   flags.WithRecordReplay,
+	}
+	if flags.WithConstructor || flags.WithTestingT || flags.Spy || flags.WithRecordReplay {
+		opts.constructor = "New" + flags.Name
 	}
 
-	err = g.addMock(name, constructor, interfaces, newFieldNameFormatter(fieldNamePrefix, fieldNameSuffix))
+	err := g.addMock(flags.Name, nil, interfaces, opts)
 	if err != nil {
 		return err
 	}
@@ -131,7 +230,7 @@ func (g *generator) addMockWithFlags(ctx context.Context, wd string, name string
 	return nil
 }
 
-func (g *generator) addMock(name string, constructor string, interfaces []types.Type, fieldNameFormatter func(string) string) error {
+func (g *generator) addMock(name string, typeParams *types.TypeParamList, interfaces []types.Type, opts mockOptions) error {
 	iface, err := overlapInterfaces(interfaces)
 	if err != nil {
 		errorMessage := err.Error()
@@ -143,41 +242,169 @@ func (g *generator) addMock(name string, constructor string, interfaces []types.
 	methods := make([]methodInfo, iface.NumMethods())
 	for i := 0; i < iface.NumMethods(); i++ {
 		method := iface.Method(i)
+		if !method.Exported() && method.Pkg() != nil && method.Pkg().Path() != g.pkg.PkgPath {
+			errorMessage := "cannot implement unexported method of other package:"
+			errorMessage += fmt.Sprintf("\n\tmock %q: %s.%s", name, method.Pkg().Path(), method.Name())
+
+			return errors.New(errorMessage)
+		}
+
 		sig := method.Type().(*types.Signature)
 
+		var reserved map[string]bool
+		if opts.paramNames {
+			reserved = newReservedNames(sig)
+		}
+
+		paramNames, paramFieldNames := newParamNames(sig.Params(), "p", reserved)
 		params := make([]paramInfo, sig.Params().Len())
 		for i := 0; i < sig.Params().Len(); i++ {
 			params[i] = paramInfo{
 				typ:        sig.Params().At(i),
+				name:       paramNames[i],
+				fieldName:  paramFieldNames[i],
 				isVariadic: i+1 == sig.Params().Len() && sig.Variadic(),
 			}
 		}
 
+		_, resultFieldNames := newParamNames(sig.Results(), "r", reserved)
 		results := make([]resultInfo, sig.Results().Len())
 		for i := 0; i < sig.Results().Len(); i++ {
 			results[i] = resultInfo{
-				typ: sig.Results().At(i),
+				typ:       sig.Results().At(i),
+				fieldName: resultFieldNames[i],
 			}
 		}
 
 		methods[i] = methodInfo{
 			typ:       method,
-			fieldName: fieldNameFormatter(method.Name()),
+			fieldName: opts.fieldNameFormatter(method.Name()),
 			params:    params,
 			results:   results,
 		}
 	}
 
+	for _, generated := range opts.generatedMethodNames(methods) {
+		for _, method := range methods {
+			if method.typ.Name() == generated {
+				errorMessage := "generated method conflicts with the interface's method:"
+				errorMessage += fmt.Sprintf("\n\tmock %q: %s", name, generated)
+
+				return errors.New(errorMessage)
+			}
+		}
+	}
+
+	if opts.withExpectations {
+		for _, method := range methods {
+			seen := map[string]bool{}
+			for _, generated := range method.expectationMethodNames() {
+				if seen[generated] {
+					errorMessage := "generated expectation method conflicts with another one:"
+					errorMessage += fmt.Sprintf("\n\tmock %q: %s.%s", name, method.typ.Name(), generated)
+
+					return errors.New(errorMessage)
+				}
+				seen[generated] = true
+			}
+		}
+	}
+
 	g.mocks = append(g.mocks, mockInfo{
+		mockOptions: opts,
 		typ:         iface,
 		name:        name,
-		constructor: constructor,
+		typeParams:  typeParams,
 		methods:     methods,
 	})
 
 	return nil
 }
 
+// addFuncMock adds the mock of the function type.
+// The function type is implemented as the interface that has only one method with the same signature,
+// and the method is named after the function type.
+func (g *generator) addFuncMock(name string, typeParams *types.TypeParamList, funcType types.Type, opts mockOptions) error {
+	methodName := "Call"
+	if named, ok := funcType.(*types.Named); ok {
+		methodName = named.Obj().Name()
+	}
+
+	// the signature should be copied, because types.NewInterfaceType sets the receiver of the method's signature.
+	sig := funcType.Underlying().(*types.Signature)
+	sig = types.NewSignatureType(nil, nil, nil, sig.Params(), sig.Results(), sig.Variadic())
+	method := types.NewFunc(token.NoPos, g.pkg.Types, methodName, sig)
+
+	// Func is generated for every mock of the function type, and returns the mock as the function.
+	for _, generated := range append(opts.generatedMethodNames([]methodInfo{{typ: method}}), "Func") {
+		if generated == methodName {
+			errorMessage := "generated method conflicts with the function type:"
+			errorMessage += fmt.Sprintf("\n\tmock %q: %s", name, methodName)
+
+			return errors.New(errorMessage)
+		}
+	}
+
+	err := g.addMock(name, typeParams, []types.Type{types.NewInterfaceType([]*types.Func{method}, nil)}, opts)
+	if err != nil {
+		return err
+	}
+	g.mocks[len(g.mocks)-1].funcType = funcType
+
+	return nil
+}
+
+// addInterface adds the interface to be written with the name.
+func (g *generator) addInterface(name string, iface *types.Interface) error {
+	for _, inter := range g.interfaces {
+		if inter.name == name {
+			return fmt.Errorf("interface %q is written by multiple mocks", name)
+		}
+	}
+	if obj := g.pkg.Types.Scope().Lookup(name); obj != nil && !strings.HasSuffix(g.pkg.Fset.File(obj.Pos()).Name(), interfaceDestination) {
+		return fmt.Errorf("%q is already declared in the package", name)
+	}
+
+	g.interfaces = append(g.interfaces, interfaceInfo{
+		name: name,
+		typ:  iface,
+	})
+
+	return nil
+}
+
+// extractInterface returns the interface that consists of the exported methods of the concrete type.
+func extractInterface(t types.Type) (*types.Interface, error) {
+	named := t
+	if ptr, ok := t.(*types.Pointer); ok {
+		named = ptr.Elem()
+	}
+	if _, ok := named.(*types.Named); !ok {
+		return nil, fmt.Errorf("non-named type: %v", t)
+	} else if _, ok := named.Underlying().(*types.Interface); ok {
+		return nil, fmt.Errorf("non-concrete type: %v", t)
+	}
+
+	var methods []*types.Func
+	ms := types.NewMethodSet(t)
+	for i := 0; i < ms.Len(); i++ {
+		obj := ms.At(i).Obj()
+		if !obj.Exported() {
+			continue
+		}
+
+		// the receiver should be removed, because the method is declared in the interface.
+		sig := ms.At(i).Type().(*types.Signature)
+		sig = types.NewSignatureType(nil, nil, nil, sig.Params(), sig.Results(), sig.Variadic())
+		methods = append(methods, types.NewFunc(obj.Pos(), obj.Pkg(), obj.Name(), sig))
+	}
+	if len(methods) == 0 {
+		return nil, fmt.Errorf("no exported methods: %v", t)
+	}
+
+	return types.NewInterfaceType(methods, nil).Complete(), nil
+}
+
 func overlapInterfaces(interfaces []types.Type) (iface *types.Interface, err error) {
 	var (
 		methods   []*types.Func
@@ -209,6 +436,186 @@ func overlapInterfaces(interfaces []types.Type) (iface *types.Interface, err err
 	return iface, err
 }
 
+type mockOptions struct {
+	constructor        string
+	fieldNameFormatter func(string) string
+	paramNames         bool
+	withExpectations   bool
+	withTestingT       bool
+	strict             bool
+	withCallOrder      bool
+	spy                bool
+	withMatchers       bool
+	withAccessors      bool
+	hideFields         bool
+	withWaiters        bool
+	withReset          bool
+	withFailures       bool
+	withLatency        bool
+	withTranscript     bool
+	withRecordReplay   bool
+}
+
+// generatedMethodNames returns the names of the methods generated in addition to the interface's methods.
+func (o mockOptions) generatedMethodNames(methods []methodInfo) []string {
+	var names []string
+	if o.withExpectations {
+		names = append(names, "AssertExpectations")
+		for _, method := range methods {
+			names = append(names, "Expect"+method.typ.Name())
+		}
+	}
+	if o.withTestingT {
+		names = append(names, "verify")
+	}
+	if o.withCallOrder {
+		names = append(names, "Calls")
+	}
+	if o.spy {
+		names = append(names, "Delegate")
+		for _, method := range methods {
+			names = append(names, "Restore"+method.typ.Name())
+		}
+	}
+	if o.withMatchers {
+		for _, method := range methods {
+			names = append(names, "Find"+method.typ.Name()+"Calls")
+		}
+	}
+	if o.withAccessors || o.hideFields {
+		for _, method := range methods {
+			name := method.typ.Name()
+			names = append(names, name+"CallCount", name+"Params", name+"History", "Set"+name+"Results", "Append"+name+"Results", "Set"+name+"Body")
+			if o.withTestingT {
+				names = append(names, "Require"+name)
+			}
+			if o.withLatency && method.takesContext() {
+				names = append(names, "Set"+name+"Delay", "Set"+name+"RespectContext")
+			}
+		}
+	}
+	if (o.withTestingT || o.strict) && !o.withAccessors && !o.hideFields {
+		for _, method := range methods {
+			names = append(names, "Set"+method.typ.Name()+"Results")
+		}
+	}
+	if o.withWaiters {
+		for _, method := range methods {
+			names = append(names, "Wait"+method.typ.Name(), method.typ.Name()+"Called")
+		}
+	}
+	if o.withReset {
+		names = append(names, "Reset")
+		for _, method := range methods {
+			names = append(names, "Reset"+method.typ.Name())
+		}
+	}
+	if o.withTranscript {
+		names = append(names, "Transcript")
+	}
+	if o.withRecordReplay {
+		names = append(names, "Record", "Replay", "UseFixture")
+	}
+	if o.withFailures {
+		names = append(names, "FailAll")
+		for _, method := range methods {
+			names = append(names, method.typ.Name()+"PanicsWith")
+			if method.returnsError() {
+				names = append(names, method.typ.Name()+"FailsAfter")
+			}
+		}
+	}
+
+	return names
+}
+
+// reservedParamNames are the identifiers that the generated methods refer to.
+var reservedParamNames = []string{"recv", "seq", "results", "configured", "supplied", "matched", "expectation", "ctxErr", "delay", "respectContext", "fmt", "reflect", "mockc"}
+
+var identRegexp = regexp.MustCompile(`[\p{L}_][\p{L}\p{N}_]*`)
+
+// newReservedNames returns the names that can't be used as the parameter names of the given signature,
+// because the generated method refers to them.
+func newReservedNames(sig *types.Signature) map[string]bool {
+	reserved := map[string]bool{}
+	for _, name := range reservedParamNames {
+		reserved[name] = true
+	}
+	for _, name := range types.Universe.Names() {
+		reserved[name] = true
+	}
+
+	qualifier := func(p *types.Package) string {
+		return p.Name()
+	}
+	for _, tuple := range []*types.Tuple{sig.Params(), sig.Results()} {
+		for i := 0; i < tuple.Len(); i++ {
+			for _, ident := range identRegexp.FindAllString(types.TypeString(tuple.At(i).Type(), qualifier), -1) {
+				reserved[ident] = true
+			}
+		}
+	}
+
+	return reserved
+}
+
+// newParamNames returns the variable names and the field names of the given tuple.
+// If reserved is nil or the declared name is not available, the index based names
+// like "p0" and "P0" are used.
+func newParamNames(tuple *types.Tuple, prefix string, reserved map[string]bool) (names []string, fieldNames []string) {
+	names = make([]string, tuple.Len())
+	fieldNames = make([]string, tuple.Len())
+	if reserved == nil {
+		for i := 0; i < tuple.Len(); i++ {
+			names[i] = fmt.Sprintf("%s%d", prefix, i)
+			fieldNames[i] = fmt.Sprintf("%s%d", strings.ToUpper(prefix), i)
+		}
+
+		return names, fieldNames
+	}
+
+	declared := map[string]bool{}
+	for i := 0; i < tuple.Len(); i++ {
+		name := tuple.At(i).Name()
+		declared[name] = true
+		declared[capitalize(name)] = true
+	}
+
+	usedNames, usedFieldNames := map[string]bool{}, map[string]bool{}
+	for i := 0; i < tuple.Len(); i++ {
+		name, fieldName := tuple.At(i).Name(), capitalize(tuple.At(i).Name())
+		if name == "" || name == "_" || reserved[name] || usedNames[name] {
+			name = uniqueName(fmt.Sprintf("%s%d", prefix, i), declared, usedNames)
+		}
+		if fieldName == "" || fieldName == "_" || usedFieldNames[fieldName] {
+			fieldName = uniqueName(fmt.Sprintf("%s%d", strings.ToUpper(prefix), i), declared, usedFieldNames)
+		}
+		usedNames[name], usedFieldNames[fieldName] = true, true
+
+		names[i], fieldNames[i] = name, fieldName
+	}
+
+	return names, fieldNames
+}
+
+func uniqueName(name string, declared map[string]bool, used map[string]bool) string {
+	for declared[name] || used[name] {
+		name += "_"
+	}
+
+	return name
+}
+
+func capitalize(s string) string {
+	if s == "" {
+		return s
+	}
+
+	r, size := utf8.DecodeRuneInString(s)
+
+	return string(unicode.ToUpper(r)) + s[size:]
+}
+
 func newFieldNameFormatter(prefix, suffix string) func(string) string {
 	return func(field string) string {
 		return prefix + field + suffix
@@ -4,10 +4,19 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/types"
+	"strings"
 
 	"golang.org/x/tools/go/packages"
 )
 
+// loadMode is the minimal mode for loading the packages.
+// The dependencies are type-checked from their export data instead of their syntax.
+const loadMode = packages.NeedName | packages.NeedFiles | packages.NeedImports | packages.NeedTypes | packages.NeedSyntax | packages.NeedTypesInfo
+
+// loadPackages loads the packages matched by the patterns at once.
 func loadPackages(ctx context.Context, wd string, patterns []string) ([]*packages.Package, error) {
 	if len(patterns) == 0 {
 		patterns = []string{"."}
@@ -20,7 +29,7 @@ func loadPackages(ctx context.Context, wd string, patterns []string) ([]*package
 
 	cfg := &packages.Config{
 		Context:    ctx,
-		Mode:       packages.LoadAllSyntax,
+		Mode:       loadMode,
 		Dir:        wd,
 		BuildFlags: []string{"-tags=mockc"},
 	}
@@ -32,7 +41,11 @@ func loadPackages(ctx context.Context, wd string, patterns []string) ([]*package
 
 	var errs []error
 	for _, p := range pkgs {
+		written := writtenInterfaces(p)
 		for _, e := range p.Errors {
+			if refersToWrittenInterfaces(e, written) {
+				continue
+			}
 			errs = append(errs, e)
 		}
 	}
@@ -47,3 +60,83 @@ func loadPackages(ctx context.Context, wd string, patterns []string) ([]*package
 
 	return pkgs, nil
 }
+
+// writtenInterfaces returns the names of the interfaces written by mockc.WriteInterface in the package.
+// The references to them are undefined until the interfaces are written for the first time,
+// so the type errors about them are tolerated, and the package is type-checked as far as possible.
+func writtenInterfaces(pkg *packages.Package) map[string]bool {
+	if pkg.TypesInfo == nil || !importsMockc(pkg) {
+		return nil
+	}
+
+	written := map[string]bool{}
+	for _, syntax := range pkg.Syntax {
+		ast.Inspect(syntax, func(node ast.Node) bool {
+			call, ok := node.(*ast.CallExpr)
+			if !ok || len(call.Args) != 1 {
+				return true
+			}
+			sel, ok := call.Fun.(*ast.SelectorExpr)
+			if !ok || sel.Sel.Name != "WriteInterface" {
+				return true
+			}
+			obj := pkg.TypesInfo.ObjectOf(sel.Sel)
+			if obj == nil || obj.Pkg() == nil || obj.Pkg().Path() != mockcPath {
+				return true
+			}
+
+			if v := pkg.TypesInfo.Types[call.Args[0]].Value; v != nil && v.Kind() == constant.String {
+				written[constant.StringVal(v)] = true
+			}
+
+			return true
+		})
+	}
+
+	return written
+}
+
+// refersToWrittenInterfaces reports whether the error is only about the undefined references to the written interfaces.
+// The same errors are reported by both the type checker and the go command, which prefixes them with the package path.
+func refersToWrittenInterfaces(e packages.Error, written map[string]bool) bool {
+	if len(written) == 0 || (e.Kind != packages.TypeError && e.Kind != packages.ListError) {
+		return false
+	}
+
+	lines := strings.Split(strings.TrimSpace(e.Msg), "\n")
+	if strings.HasPrefix(lines[0], "# ") {
+		lines = lines[1:]
+	}
+	if len(lines) == 0 {
+		return false
+	}
+	for _, line := range lines {
+		idx := strings.LastIndex(line, "undefined: ")
+		if idx == -1 || !written[line[idx+len("undefined: "):]] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func importsMockc(pkg *packages.Package) bool {
+	return importedPackage(pkg, mockcPath) != nil
+}
+
+// importedPackage returns the package of the path if it is the package itself or is imported by the package.
+func importedPackage(pkg *packages.Package, path string) *types.Package {
+	if pkg.Types == nil {
+		return nil
+	} else if pkg.Types.Path() == path {
+		return pkg.Types
+	}
+
+	for _, imported := range pkg.Types.Imports() {
+		if imported.Path() == path {
+			return imported
+		}
+	}
+
+	return nil
+}
@@ -2,76 +2,457 @@ package mockc
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"go/token"
+	"io"
+	"log"
+	"os"
 	"path/filepath"
+	"sort"
 	"strings"
+	"time"
+
+	"golang.org/x/tools/go/packages"
 )
 
 const (
 	mockcPath              = "github.com/KimMachineGun/mockc"
 	defaultDestination     = "mockc_gen.go"
+	interfaceDestination   = "mockc_interface_gen.go"
 	defaultFieldNamePrefix = "_"
 	defaultFieldNameSuffix = ""
+	stdoutDestination      = "-"
 )
 
-func Generate(ctx context.Context, wd string, patterns []string) error {
+// Options describes how the mocks are generated.
+type Options struct {
+	// Check makes the mocks be compared with the files on the disk instead of being written.
+	Check bool
+	// DryRun makes the mocks be written to the Output instead of the files.
+	DryRun bool
+	// Output is where the mocks are written in dry-run mode or with the stdout destination.
+	// If it is nil, os.Stdout will be used.
+	Output io.Writer
+	// Verbose makes the time taken to load the packages and to generate the mocks be logged.
+	Verbose bool
+	// Jobs is the maximum number of the packages parsed and rendered concurrently.
+	// If it is not positive, runtime.GOMAXPROCS(0) will be used.
+	Jobs int
+	// Cache is the path of the file that caches the method set hashes of the generated mocks.
+	// If it is not empty, the generators whose mocks and files have not been changed since the last generation will not be rendered.
+	// It's ignored in check mode and dry-run mode, and when the executable of mockc cannot be read to identify its build.
+	Cache string
+}
+
+func (o Options) loadCache() (*cache, error) {
+	if o.Cache == "" || o.Check || o.DryRun || buildID() == "" {
+		return nil, nil
+	}
+
+	return loadCache(o.Cache)
+}
+
+// render renders the generator unless the cache has the same key for its destination.
+func (o Options) render(c *cache, g *generator, gogenerate string) (renderedFile, error) {
+	f := renderedFile{
+		generator: g,
+	}
+	if c != nil && g.path != stdoutDestination {
+		f.key = g.cacheKey(gogenerate)
+		if c.hit(g.path, f.key) {
+			f.cached = true
+			return f, nil
+		}
+	}
+
+	b, err := g.Render(gogenerate)
+	if err != nil {
+		return renderedFile{}, err
+	}
+	f.src = b
+
+	return f, nil
+}
+
+func (o Options) logElapsed(start time.Time, format string, args ...interface{}) {
+	if !o.Verbose {
+		return
+	}
+
+	log.Printf(format+" in %v", append(args, time.Since(start).Round(time.Millisecond))...)
+}
+
+func (o Options) output() io.Writer {
+	if o.Output == nil {
+		return os.Stdout
+	}
+
+	return o.Output
+}
+
+// emit writes, checks, or prints the rendered mocks of the generator according to the options.
+// It returns false if the mocks are out of date in check mode.
+func (o Options) emit(g *generator, b []byte) (bool, error) {
+	switch {
+	case b == nil:
+		return true, nil
+	case o.Check:
+		return g.Check(b)
+	case o.DryRun || g.path == stdoutDestination:
+		return true, g.Print(o.output(), b)
+	default:
+		return true, g.Generate(b)
+	}
+}
+
+// emitAll emits the rendered files in order, and returns all the errors that occurred.
+func (o Options) emitAll(c *cache, files []renderedFile) []error {
+	var (
+		errs      []error
+		outOfDate int
+	)
+	for _, f := range files {
+		if f.cached {
+			log.Println("cached:", f.generator.path)
+			continue
+		}
+
+		upToDate, err := o.emit(f.generator, f.src)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: cannot generate mock: %v", f.name, err))
+		} else if !upToDate {
+			outOfDate++
+		} else if f.key != "" {
+			c.update(f.generator.path, f.key, f.src)
+		}
+	}
+	if err := outOfDateError(outOfDate); err != nil {
+		errs = append(errs, err)
+	}
+	if err := c.save(); err != nil {
+		errs = append(errs, err)
+	}
+
+	return errs
+}
+
+func Generate(ctx context.Context, wd string, patterns []string, opts Options) error {
+	start := time.Now()
 	pkgs, err := loadPackages(ctx, wd, patterns)
 	if err != nil {
 		return fmt.Errorf("cannot load packages: %v", err)
 	}
+	opts.logElapsed(start, "loaded %d package(s)", len(pkgs))
 
-	for _, pkg := range pkgs {
-		if _, ok := pkg.Imports[mockcPath]; !ok {
-			continue
+	sort.Slice(pkgs, func(i, j int) bool {
+		return pkgs[i].PkgPath < pkgs[j].PkgPath
+	})
+
+	c, err := opts.loadCache()
+	if err != nil {
+		return err
+	}
+
+	interfacePkgs, err := loadInterfacePackages(ctx, wd, pkgs, opts)
+	if err != nil {
+		return err
+	}
+
+	start = time.Now()
+	files := make([][]renderedFile, len(pkgs))
+	errs := make([]error, len(pkgs))
+	parallel(opts.Jobs, len(pkgs), func(i int) {
+		pkg := pkgs[i]
+		if !importsMockc(pkg) {
+			return
 		}
 
-		generators, err := newParser(pkg).parse()
+		generators, err := newParser(pkg, interfacePkgs).parse()
 		if err != nil {
-			return err
+			errs[i] = err
+			return
 		}
 
 		for _, generator := range generators {
-			err = generator.Generate("mockc")
+			f, err := opts.render(c, generator, "mockc")
 			if err != nil {
-				return fmt.Errorf("package %q: cannot generate mock: %v", pkg.PkgPath, err)
+				errs[i] = fmt.Errorf("package %q: cannot generate mock: %v", pkg.PkgPath, err)
+				return
 			}
+			f.name = fmt.Sprintf("package %q", pkg.PkgPath)
+
+			files[i] = append(files[i], f)
 		}
+	})
+
+	var rendered []renderedFile
+	for _, f := range files {
+		rendered = append(rendered, f...)
 	}
+	opts.logElapsed(start, "rendered %d file(s)", len(rendered))
 
-	return nil
+	return errors.Join(append(nonNilErrors(errs), opts.emitAll(c, rendered)...)...)
 }
 
-func GenerateWithFlags(ctx context.Context, wd string, destination string, name string, withConstructor bool, fieldNamePrefix string, fieldNameSuffix string, interfacePatterns []string) error {
-	destination, err := filepath.Abs(destination)
-	if err != nil {
-		return fmt.Errorf("cannot convert destination into absolute path: %v", err)
+// loadInterfacePackages loads the packages of the interfaces designated by mockc.ImplementPath.
+// The packages don't have to be imported by the mock generators, and their paths are known only after the generators are loaded,
+// so they can't be loaded with the generators. Instead, the packages that are not loaded yet are loaded at once by a second load,
+// which is skipped if there are no such packages and timed separately in verbose mode.
+func loadInterfacePackages(ctx context.Context, wd string, pkgs []*packages.Package, opts Options) (map[string]*packages.Package, error) {
+	result := map[string]*packages.Package{}
+	for _, pkg := range pkgs {
+		result[pkg.PkgPath] = pkg
 	}
 
-	destinationDir, fileName := filepath.Split(destination)
-	if filepath.Ext(fileName) != ".go" {
-		return fmt.Errorf("destination should be a go file: %s", fileName)
-	} else if destinationDir == "" {
-		destinationDir = "."
+	var patterns []string
+	for _, pkg := range pkgs {
+		if !importsMockc(pkg) {
+			continue
+		}
+
+		for _, path := range implementPaths(pkg) {
+			if _, ok := result[path]; !ok && importedPackage(pkg, path) == nil {
+				patterns = append(patterns, path)
+			}
+		}
+	}
+	if len(patterns) == 0 {
+		return result, nil
 	}
 
-	pkgs, err := loadPackages(ctx, wd, []string{destinationDir})
+	start := time.Now()
+	interfacePkgs, err := loadPackages(ctx, wd, patterns)
 	if err != nil {
-		return fmt.Errorf("cannot load destination package: %v", err)
-	} else if len(pkgs) != 1 {
-		return fmt.Errorf("muptile destination packages are loaded: %v", pkgs)
+		return nil, fmt.Errorf("cannot load interface packages: %v", err)
+	}
+	opts.logElapsed(start, "loaded %d interface package(s)", len(interfacePkgs))
+
+	for _, pkg := range interfacePkgs {
+		result[pkg.PkgPath] = pkg
+	}
+
+	return result, nil
+}
+
+func outOfDateError(n int) error {
+	if n == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("%d mock file(s) are out of date", n)
+}
+
+// MockFlags describes the mock to be generated in command line flags mode.
+type MockFlags struct {
+	Destination      string
+	Package          string
+	Name             string
+	WithConstructor  bool
+	FieldNamePrefix  string
+	FieldNameSuffix  string
+	ParamNames       bool
+	WithExpectations bool
+	WithTestingT     bool
+	Strict           bool
+	WithCallOrder    bool
+	Spy              bool
+	WithMatchers     bool
+	WithAccessors    bool
+	HideFields       bool
+	WithWaiters      bool
+	WithReset        bool
+	WithFailures     bool
+	WithLatency      bool
+	WithTranscript   bool
+	WithRecordReplay bool
+	Interfaces       []string
+}
+
+func (f MockFlags) goGenerate() string {
+	gogenerate := fmt.Sprintf("mockc \"-destination=%s\" \"-name=%s\" \"-withConstructor=%t\" \"-fieldNamePrefix=%s\" \"-fieldNameSuffix=%s\"", filepath.Base(f.Destination), f.Name, f.WithConstructor, f.FieldNamePrefix, f.FieldNameSuffix)
+	if f.Package != "" {
+		gogenerate += fmt.Sprintf(" \"-package=%s\"", f.Package)
+	}
+	if f.ParamNames {
+		gogenerate += " \"-paramNames\""
+	}
+	if f.WithExpectations {
+		gogenerate += " \"-withExpectations\""
+	}
+	if f.WithTestingT {
+		gogenerate += " \"-withTestingT\""
+	}
+	if f.Strict {
+		gogenerate += " \"-strict\""
+	}
+	if f.WithCallOrder {
+		gogenerate += " \"-withCallOrder\""
+	}
+	if f.Spy {
+		gogenerate += " \"-spy\""
+	}
+	if f.WithMatchers {
+		gogenerate += " \"-withMatchers\""
+	}
+	if f.WithAccessors {
+		gogenerate += " \"-withAccessors\""
+	}
+	if f.HideFields {
+		gogenerate += " \"-hideFields\""
+	}
+	if f.WithWaiters {
+		gogenerate += " \"-withWaiters\""
+	}
+	if f.WithReset {
+		gogenerate += " \"-withReset\""
+	}
+	if f.WithFailures {
+		gogenerate += " \"-withFailures\""
 	}
+	if f.WithLatency {
+		gogenerate += " \"-withLatency\""
+	}
+	if f.WithTranscript {
+		gogenerate += " \"-withTranscript\""
+	}
+	if f.WithRecordReplay {
+		gogenerate += " \"-withRecordReplay\""
+	}
+	gogenerate += fmt.Sprintf(" \"%s\"", strings.Join(f.Interfaces, " "))
 
-	generator := newGenerator(pkgs[0], destination)
+	return gogenerate
+}
 
-	err = generator.addMockWithFlags(ctx, wd, name, withConstructor, fieldNamePrefix, fieldNameSuffix, interfacePatterns)
+func GenerateWithFlags(ctx context.Context, wd string, flags MockFlags, opts Options) error {
+	if flags.Destination != stdoutDestination && !filepath.IsAbs(flags.Destination) {
+		flags.Destination = filepath.Join(wd, flags.Destination)
+	}
+
+	return generateWithFlags(ctx, wd, []MockFlags{flags}, opts, func(destination string, mocks []MockFlags) string {
+		return mocks[0].goGenerate()
+	})
+}
+
+// generateWithFlags generates the mocks with a single package load.
+// The mocks that have the same destination will be generated into the same file.
+func generateWithFlags(ctx context.Context, wd string, mocks []MockFlags, opts Options, goGenerate func(destination string, mocks []MockFlags) string) error {
+	var (
+		patterns     []string
+		destinations []string
+		mocksByDest  = map[string][]MockFlags{}
+	)
+	for _, flags := range mocks {
+		if flags.Package != "" && !token.IsIdentifier(flags.Package) {
+			return fmt.Errorf("package should be a valid identifier: %s", flags.Package)
+		}
+
+		destination := flags.Destination
+		if destination == stdoutDestination {
+			if flags.Package == "" {
+				return errors.New("package flag is required when the destination is stdout")
+			} else if opts.Check {
+				return errors.New("check mode cannot be used when the destination is stdout")
+			}
+		} else {
+			var err error
+			destination, err = filepath.Abs(destination)
+			if err != nil {
+				return fmt.Errorf("cannot convert destination into absolute path: %v", err)
+			}
+
+			if fileName := filepath.Base(destination); filepath.Ext(fileName) != ".go" {
+				return fmt.Errorf("destination should be a go file: %s", fileName)
+			}
+
+			patterns = append(patterns, filepath.Dir(destination))
+		}
+
+		for _, inter := range flags.Interfaces {
+			pkgPath, _, err := parseInterfacePattern(inter)
+			if err != nil {
+				return err
+			}
+
+			patterns = append(patterns, pkgPath)
+		}
+
+		if _, ok := mocksByDest[destination]; !ok {
+			destinations = append(destinations, destination)
+		}
+		mocksByDest[destination] = append(mocksByDest[destination], flags)
+	}
+
+	start := time.Now()
+	pkgs, err := loadPackages(ctx, wd, patterns)
 	if err != nil {
-		return err
+		return fmt.Errorf("cannot load packages: %v", err)
 	}
+	opts.logElapsed(start, "loaded %d package(s)", len(pkgs))
 
-	err = generator.Generate(fmt.Sprintf("mockc \"-destination=%s\" \"-name=%s\" \"-withConstructor=%t\" \"-fieldNamePrefix=%s\" \"-fieldNameSuffix=%s\" \"%s\"", fileName, name, withConstructor, fieldNamePrefix, fieldNameSuffix, strings.Join(interfacePatterns, " ")))
+	c, err := opts.loadCache()
 	if err != nil {
-		return fmt.Errorf("cannot generate mock: %v", err)
+		return err
+	}
+
+	pkgsByPath := make(map[string]*packages.Package, len(pkgs))
+	pkgsByDir := make(map[string]*packages.Package, len(pkgs))
+	for _, pkg := range pkgs {
+		pkgsByPath[pkg.PkgPath] = pkg
+		pkgsByDir[pkg.Dir] = pkg
+	}
+
+	start = time.Now()
+	files := make([]renderedFile, len(destinations))
+	errs := make([]error, len(destinations))
+	parallel(opts.Jobs, len(destinations), func(i int) {
+		destination := destinations[i]
+		mocks := mocksByDest[destination]
+
+		var generator *generator
+		if destination == stdoutDestination {
+			generator = newGenerator(&packages.Package{Name: mocks[0].Package}, stdoutDestination)
+		} else {
+			pkg, ok := pkgsByDir[filepath.Dir(destination)]
+			if !ok {
+				errs[i] = fmt.Errorf("cannot load destination package: %s", filepath.Dir(destination))
+				return
+			}
+			for _, flags := range mocks {
+				if flags.Package != "" && flags.Package != pkg.Name {
+					errs[i] = fmt.Errorf("package %q doesn't match the destination package %q", flags.Package, pkg.Name)
+					return
+				}
+			}
+
+			generator = newGenerator(pkg, destination)
+		}
+
+		for _, flags := range mocks {
+			err := generator.addMockWithFlags(pkgsByPath, flags)
+			if err != nil {
+				errs[i] = err
+				return
+			}
+		}
+
+		f, err := opts.render(c, generator, goGenerate(destination, mocks))
+		if err != nil {
+			errs[i] = fmt.Errorf("cannot generate mock: %v", err)
+			return
+		}
+		f.name = fmt.Sprintf("destination %q", destination)
+
+		files[i] = f
+	})
+	opts.logElapsed(start, "rendered %d file(s)", len(destinations))
+
+	var rendered []renderedFile
+	for _, f := range files {
+		if f.generator != nil {
+			rendered = append(rendered, f)
+		}
 	}
 
-	return nil
+	return errors.Join(append(nonNilErrors(errs), opts.emitAll(c, rendered)...)...)
 }
@@ -24,9 +24,16 @@ type testCase struct {
 
 	input struct {
 		Patterns []string
+		Config   string
+		Flags    *MockFlags
+		Check    bool
+		DryRun   bool
+		Verbose  bool
+		Cache    bool
 	}
 	output struct {
 		Output string
+		Stdout string
 		Err    string
 	}
 	expectedFiles map[string][]byte
@@ -71,13 +78,26 @@ func TestMockc(t *testing.T) {
 		err = json.Unmarshal(output, &tc.output)
 		a.NoError(err)
 
-		expectedFiles, err := filepath.Glob(filepath.Join(tc.path, "testdata", "*.go.gen"))
-		a.NoError(err)
-		for _, f := range expectedFiles {
+		expectedRoot := filepath.Join(tc.path, "testdata")
+		err = filepath.Walk(expectedRoot, func(f string, info os.FileInfo, err error) error {
+			if err != nil || info.IsDir() || !strings.HasSuffix(f, ".go.gen") {
+				return err
+			}
+
 			b, err := ioutil.ReadFile(f)
-			a.NoError(err)
-			tc.expectedFiles[strings.TrimSuffix(filepath.Base(f), ".gen")] = b
-		}
+			if err != nil {
+				return err
+			}
+
+			name, err := filepath.Rel(expectedRoot, f)
+			if err != nil {
+				return err
+			}
+			tc.expectedFiles[strings.TrimSuffix(name, ".gen")] = b
+
+			return nil
+		})
+		a.NoError(err)
 
 		testCases = append(testCases, tc)
 	}
@@ -95,13 +115,42 @@ func testMockc(t *testing.T, tc testCase) {
 		log.SetFlags(0)
 		log.SetOutput(buf)
 
-		err := Generate(context.Background(), tc.path, tc.input.Patterns)
+		stdout := bytes.NewBuffer(nil)
+
+		opts := Options{
+			Check:   tc.input.Check,
+			DryRun:  tc.input.DryRun,
+			Output:  stdout,
+			Verbose: tc.input.Verbose,
+		}
+
+		// with the cache, mocks are generated twice to see whether the second generation hits the cache.
+		runs := 1
+		if tc.input.Cache {
+			opts.Cache = filepath.Join(t.TempDir(), "cache.json")
+			runs = 2
+		}
+
+		var err error
+		for i := 0; i < runs; i++ {
+			if tc.input.Config != "" {
+				err = GenerateWithConfig(context.Background(), tc.path, tc.input.Config, opts)
+			} else if tc.input.Flags != nil {
+				err = GenerateWithFlags(context.Background(), tc.path, *tc.input.Flags, opts)
+			} else {
+				err = Generate(context.Background(), tc.path, tc.input.Patterns, opts)
+			}
+		}
 		if tc.output.Err == "" {
 			a.NoError(err)
 		} else {
 			a.EqualError(err, tc.output.Err)
 		}
 		a.Regexp(regexp.MustCompile(tc.output.Output), buf.String())
+		a.Regexp(regexp.MustCompile(tc.output.Stdout), stdout.String())
+		if tc.input.DryRun {
+			a.NoFileExists(filepath.Join(tc.path, defaultDestination))
+		}
 
 		t.Cleanup(func() {
 			for name := range tc.expectedFiles {
@@ -0,0 +1,60 @@
+package mockc
+
+import (
+	"runtime"
+	"sync"
+)
+
+// renderedFile is the rendered mocks of the generator, which are not emitted yet.
+// The files are rendered concurrently, and emitted in order to keep the output deterministic.
+type renderedFile struct {
+	name      string
+	generator *generator
+	src       []byte
+	// key is the cache key of the generator, and it is empty if the cache is not used.
+	key string
+	// cached is true if the generator has not been rendered because of the cache hit.
+	cached bool
+}
+
+// parallel calls fn for every index in [0, n) with at most jobs goroutines.
+// If jobs is not positive, runtime.GOMAXPROCS(0) will be used.
+func parallel(jobs int, n int, fn func(i int)) {
+	if jobs <= 0 {
+		jobs = runtime.GOMAXPROCS(0)
+	}
+	if jobs > n {
+		jobs = n
+	}
+
+	indexes := make(chan int)
+
+	var wg sync.WaitGroup
+	wg.Add(jobs)
+	for j := 0; j < jobs; j++ {
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				fn(i)
+			}
+		}()
+	}
+
+	for i := 0; i < n; i++ {
+		indexes <- i
+	}
+	close(indexes)
+
+	wg.Wait()
+}
+
+func nonNilErrors(errs []error) []error {
+	var result []error
+	for _, err := range errs {
+		if err != nil {
+			result = append(result, err)
+		}
+	}
+
+	return result
+}
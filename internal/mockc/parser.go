@@ -4,9 +4,11 @@ import (
 	"errors"
 	"fmt"
 	"go/ast"
+	"go/token"
 	"go/types"
 	"log"
 	"path/filepath"
+	"sort"
 	"strconv"
 
 	"golang.org/x/tools/go/packages"
@@ -14,11 +16,14 @@ import (
 
 type parser struct {
 	pkg *packages.Package
+	// pkgs are the packages of the interfaces designated by mockc.ImplementPath.
+	pkgs map[string]*packages.Package
 }
 
-func newParser(pkg *packages.Package) *parser {
+func newParser(pkg *packages.Package, pkgs map[string]*packages.Package) *parser {
 	return &parser{
-		pkg: pkg,
+		pkg:  pkg,
+		pkgs: pkgs,
 	}
 }
 
@@ -45,10 +50,28 @@ func (p *parser) parse() ([]*generator, error) {
 				pkgDir          = filepath.Dir(p.pkg.Fset.File(decl.Pos()).Name())
 				destination     = defaultDestination
 				name            = fun.Name.Name
+				typeParams      = p.pkg.TypesInfo.Defs[fun.Name].Type().(*types.Signature).TypeParams()
 				constructor     string
 				fieldNamePrefix = defaultFieldNamePrefix
 				fieldNameSuffix = defaultFieldNameSuffix
+				paramNames      bool
+				expectations    bool
+				testingT        bool
+				strict          bool
+				callOrder       bool
+				spy             bool
+				matchers        bool
+				accessors       bool
+				hideFields      bool
+				waiters         bool
+				reset           bool
+				failures        bool
+				latency         bool
+				transcript      bool
+				recordReplay    bool
 				interfaces      []types.Type
+				funcs           []types.Type
+				interfaceName   string
 			)
 
 			for _, call := range calls {
@@ -81,6 +104,80 @@ func (p *parser) parse() ([]*generator, error) {
 							interfaces = append(interfaces, t.Underlying())
 						}
 					}
+				case "ImplementFunc":
+					t := p.pkg.TypesInfo.TypeOf(call.Args[0])
+
+					_, ok := t.Underlying().(*types.Signature)
+					if !ok {
+						errorMessage := "non-function:"
+						errorMessage += fmt.Sprintf("\n\tmock %q: %v", fun.Name.Name, t)
+
+						return nil, errors.New(errorMessage)
+					}
+
+					funcs = append(funcs, t)
+				case "ExtractInterface":
+					for _, arg := range call.Args {
+						iface, err := extractInterface(p.pkg.TypesInfo.TypeOf(arg))
+						if err != nil {
+							errorMessage := "cannot extract interface:"
+							errorMessage += fmt.Sprintf("\n\tmock %q: %v", fun.Name.Name, err)
+
+							return nil, errors.New(errorMessage)
+						}
+
+						interfaces = append(interfaces, iface)
+					}
+				case "WriteInterface":
+					arg := call.Args[0]
+					res, err := types.Eval(p.pkg.Fset, p.pkg.Types, arg.Pos(), types.ExprString(arg))
+					if err != nil {
+						errorMessage := "cannot write interface:"
+						errorMessage += fmt.Sprintf("\n\tmock %q: %v", fun.Name.Name, err)
+
+						return nil, errors.New(errorMessage)
+					}
+
+					interfaceName, err = strconv.Unquote(res.Value.ExactString())
+					if err != nil {
+						errorMessage := "cannot write interface:"
+						errorMessage += fmt.Sprintf("\n\tmock %q: %v", fun.Name.Name, err)
+
+						return nil, errors.New(errorMessage)
+					} else if !token.IsIdentifier(interfaceName) {
+						errorMessage := "cannot write interface:"
+						errorMessage += fmt.Sprintf("\n\tmock %q: %q is not a valid identifier", fun.Name.Name, interfaceName)
+
+						return nil, errors.New(errorMessage)
+					}
+				case "ImplementPath":
+					for _, arg := range call.Args {
+						res, err := types.Eval(p.pkg.Fset, p.pkg.Types, arg.Pos(), types.ExprString(arg))
+						if err != nil {
+							errorMessage := "cannot implement interface path:"
+							errorMessage += fmt.Sprintf("\n\tmock %q: %v", fun.Name.Name, err)
+
+							return nil, errors.New(errorMessage)
+						}
+
+						val, err := strconv.Unquote(res.Value.ExactString())
+						if err != nil {
+							errorMessage := "cannot implement interface path:"
+							errorMessage += fmt.Sprintf("\n\tmock %q: %v", fun.Name.Name, err)
+
+							return nil, errors.New(errorMessage)
+						}
+
+						t, err := p.lookupInterface(val)
+						if err != nil {
+							errorMessage := "cannot implement interface path:"
+							errorMessage += fmt.Sprintf("\n\tmock %q: %v", fun.Name.Name, err)
+
+							return nil, errors.New(errorMessage)
+						}
+
+						interfaces = append(interfaces, t)
+					}
 				case "SetFieldNamePrefix":
 					arg := call.Args[0]
 					res, err := types.Eval(p.pkg.Fset, p.pkg.Types, arg.Pos(), types.ExprString(arg))
@@ -144,6 +241,36 @@ func (p *parser) parse() ([]*generator, error) {
 					}
 
 					destination = val
+				case "UseParamNames":
+					paramNames = true
+				case "WithExpectations":
+					expectations = true
+				case "WithTestingT":
+					testingT = true
+				case "Strict":
+					strict = true
+				case "WithCallOrder":
+					callOrder = true
+				case "AsSpy":
+					spy = true
+				case "WithMatchers":
+					matchers = true
+				case "WithAccessors":
+					accessors = true
+				case "HideFields":
+					hideFields = true
+				case "WithWaiters":
+					waiters = true
+				case "WithReset":
+					reset = true
+				case "WithFailures":
+					failures = true
+				case "WithLatency":
+					latency = true
+				case "WithTranscript":
+					transcript = true
+				case "WithRecordReplay":
+					recordReplay = true
 				case "WithConstructor":
 					constructor = "New" + name
 				case "SetConstructorName":
@@ -180,20 +307,79 @@ func (p *parser) parse() ([]*generator, error) {
 				return nil, errors.New(errorMessage)
 			}
 
+			if len(funcs) > 1 || (len(funcs) > 0 && len(interfaces) > 0) {
+				errorMessage := "mock should implement either one function type or interfaces:"
+				errorMessage += fmt.Sprintf("\n\tmock %q", fun.Name.Name)
+
+				return nil, errors.New(errorMessage)
+			}
+
+			if (testingT || spy || recordReplay) && constructor == "" {
+				constructor = "New" + name
+			}
+
+			if destination == interfaceDestination {
+				errorMessage := "cannot set destination:"
+				errorMessage += fmt.Sprintf("\n\tmock %q: %q is reserved for the written interfaces", fun.Name.Name, destination)
+
+				return nil, errors.New(errorMessage)
+			}
+
 			destination = filepath.Join(pkgDir, destination)
 			if destinationsAndGenerators[destination] == nil {
 				destinationsAndGenerators[destination] = newGenerator(p.pkg, destination)
 			}
 
-			err = destinationsAndGenerators[destination].addMock(
-				name,
-				constructor,
-				interfaces,
-				newFieldNameFormatter(fieldNamePrefix, fieldNameSuffix),
-			)
+			opts := mockOptions{
+				constructor:        constructor,
+				fieldNameFormatter: newFieldNameFormatter(fieldNamePrefix, fieldNameSuffix),
+				paramNames:         paramNames,
+				withExpectations:   expectations,
+				withTestingT:       testingT,
+				strict:             strict,
+				withCallOrder:      callOrder || transcript,
+				spy:                spy || recordReplay,
+				withMatchers:       matchers,
+				withAccessors:      accessors,
+				hideFields:         hideFields,
+				withWaiters:        waiters,
+				withReset:          reset,
+				withFailures:       failures,
+				withLatency:        latency,
+				withTranscript:     transcript,
+				withRecordReplay:   recordReplay,
+			}
+			if len(funcs) > 0 {
+				err = destinationsAndGenerators[destination].addFuncMock(name, typeParams, funcs[0], opts)
+			} else {
+				err = destinationsAndGenerators[destination].addMock(name, typeParams, interfaces, opts)
+			}
 			if err != nil {
 				return nil, err
 			}
+
+			if interfaceName != "" {
+				if typeParams.Len() > 0 {
+					errorMessage := "cannot write interface:"
+					errorMessage += fmt.Sprintf("\n\tmock %q: generic mock is not supported", fun.Name.Name)
+
+					return nil, errors.New(errorMessage)
+				}
+
+				interfaceDestination := filepath.Join(pkgDir, interfaceDestination)
+				if destinationsAndGenerators[interfaceDestination] == nil {
+					destinationsAndGenerators[interfaceDestination] = newGenerator(p.pkg, interfaceDestination)
+				}
+
+				mocks := destinationsAndGenerators[destination].mocks
+				err = destinationsAndGenerators[interfaceDestination].addInterface(interfaceName, mocks[len(mocks)-1].typ)
+				if err != nil {
+					errorMessage := "cannot write interface:"
+					errorMessage += fmt.Sprintf("\n\tmock %q: %v", fun.Name.Name, err)
+
+					return nil, errors.New(errorMessage)
+				}
+			}
 		}
 	}
 	if len(destinationsAndGenerators) == 0 {
@@ -204,10 +390,94 @@ func (p *parser) parse() ([]*generator, error) {
 	for _, generator := range destinationsAndGenerators {
 		generators = append(generators, generator)
 	}
+	sort.Slice(generators, func(i, j int) bool {
+		return generators[i].path < generators[j].path
+	})
 
 	return generators, nil
 }
 
+// lookupInterface finds the named interface type designated by the pattern {package_path}.{interface_name}.
+func (p *parser) lookupInterface(pattern string) (types.Type, error) {
+	pkgPath, interfaceName, err := parseInterfacePattern(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	// the packages imported by the mock generator are preferred to keep the identities of their types.
+	typesPkg := importedPackage(p.pkg, pkgPath)
+	if typesPkg == nil {
+		pkg, ok := p.pkgs[pkgPath]
+		if !ok || pkg.Types == nil {
+			return nil, fmt.Errorf("cannot load package: %s", pkgPath)
+		}
+		typesPkg = pkg.Types
+	}
+
+	obj, ok := typesPkg.Scope().Lookup(interfaceName).(*types.TypeName)
+	if !ok {
+		return nil, fmt.Errorf("cannot find interface: %s", pattern)
+	} else if !obj.Exported() && pkgPath != p.pkg.PkgPath {
+		return nil, fmt.Errorf("unexported interface: %s", pattern)
+	}
+
+	t := obj.Type()
+	if _, ok := t.Underlying().(*types.Interface); !ok {
+		return nil, fmt.Errorf("non-interface: %s", pattern)
+	} else if named, ok := t.(*types.Named); ok && named.TypeParams().Len() > 0 {
+		return nil, fmt.Errorf("generic interface is not supported: %s", pattern)
+	}
+
+	return t, nil
+}
+
+// implementPaths returns the package paths of the interfaces designated by mockc.ImplementPath.
+// The invalid calls are ignored here, and they will be reported while parsing.
+func implementPaths(pkg *packages.Package) []string {
+	var paths []string
+	for _, syntax := range pkg.Syntax {
+		ast.Inspect(syntax, func(node ast.Node) bool {
+			call, ok := node.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+
+			sel, ok := call.Fun.(*ast.SelectorExpr)
+			if !ok {
+				return true
+			}
+
+			obj := pkg.TypesInfo.ObjectOf(sel.Sel)
+			if obj == nil || obj.Pkg() == nil || obj.Pkg().Path() != mockcPath || obj.Name() != "ImplementPath" {
+				return true
+			}
+
+			for _, arg := range call.Args {
+				tv, ok := pkg.TypesInfo.Types[arg]
+				if !ok || tv.Value == nil {
+					continue
+				}
+
+				val, err := strconv.Unquote(tv.Value.ExactString())
+				if err != nil {
+					continue
+				}
+
+				pkgPath, _, err := parseInterfacePattern(val)
+				if err != nil {
+					continue
+				}
+
+				paths = append(paths, pkgPath)
+			}
+
+			return true
+		})
+	}
+
+	return paths
+}
+
 func (p *parser) findMockcCalls(stmts []ast.Stmt) ([]*ast.CallExpr, error) {
 	var (
 		calls   []*ast.CallExpr
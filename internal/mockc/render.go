@@ -16,70 +16,97 @@ func render(pkg *packages.Package, mocks []mockInfo, gogenerate string) ([]byte,
 
 	f.PackageComment("// Code generated by Mockc. DO NOT EDIT.")
 	f.PackageComment("// repo: https://github.com/KimMachineGun/mockc\n")
-	f.PackageComment(fmt.Sprintf("//go:generate %s", gogenerate))
+	if gogenerate != "" {
+		f.PackageComment(fmt.Sprintf("//go:generate %s", gogenerate))
+	}
+	f.PackageComment("//go:build !mockc")
 	f.PackageComment("// +build !mockc\n")
 
 	for _, mock := range mocks {
-		f.Var().Id("_").Do(func(s *jen.Statement) {
-			typeCode(s, mock.typ)
-		}).Op("=").Op("&").Id(mock.name).Values()
-		f.Type().Id(mock.name).StructFunc(func(g *jen.Group) {
+		mock := mock
+
+		assertion := jen.Var().Id("_").Do(mock.implementedTypeCode).Op("=").Op("&").Do(func(s *jen.Statement) {
+			mockTypeCode(s, mock)
+		}).Values()
+		if mock.funcType != nil {
+			assertion = jen.Var().Id("_").Do(mock.implementedTypeCode).Op("=").Parens(jen.Op("&").Do(func(s *jen.Statement) {
+				mockTypeCode(s, mock)
+			}).Values()).Dot(mock.methods[0].typ.Name())
+		}
+		if mock.typeParams.Len() > 0 {
+			f.Func().Id("_").TypesFunc(func(g *jen.Group) {
+				typeParamsCode(g, mock.typeParams)
+			}).Params().Block(assertion)
+		} else {
+			f.Add(assertion)
+		}
+		f.Type().Id(mock.name).TypesFunc(func(g *jen.Group) {
+			typeParamsCode(g, mock.typeParams)
+		}).StructFunc(func(g *jen.Group) {
+			if mock.withTestingT {
+				g.Id("t").Qual("testing", "TB")
+			}
+			if mock.spy {
+				g.Id("delegate").Do(mock.implementedTypeCode)
+			}
+			if mock.withRecordReplay {
+				g.Id("fixture").Op("*").Qual(mockcPath, "Fixture")
+			}
 			for _, method := range mock.methods {
 				g.Commentf("method: %s", method.typ.Name())
 				g.Id(method.fieldName).StructFunc(func(g *jen.Group) {
 					g.Id("mu").Qual("sync", "Mutex")
 					g.Comment("basics")
-					g.Id("Called").Bool()
-					g.Id("CallCount").Int()
-					if len(method.params)+len(method.results) > 0 {
+					g.Id(mock.field("Called")).Bool()
+					g.Id(mock.field("CallCount")).Int()
+					if mock.withWaiters {
+						g.Comment("it'll be closed on the next call.")
+						g.Id("notify").Chan().Struct()
+					}
+					if mock.hasHistory(method) {
 						g.Comment("call history")
-						g.Id("History").Index().StructFunc(func(g *jen.Group) {
-							if len(method.params) > 0 {
-								g.Id("Params").StructFunc(func(g *jen.Group) {
-									for i, param := range method.params {
-										param := param
-										g.Do(func(s *jen.Statement) {
-											typeCode(s.Id(fmt.Sprintf("P%d", i)), param.typ.Type())
-										})
-									}
-								})
-							}
-							if len(method.results) > 0 {
-								g.Id("Results").StructFunc(func(g *jen.Group) {
-									for i, result := range method.results {
-										result := result
-										g.Do(func(s *jen.Statement) {
-											typeCode(s.Id(fmt.Sprintf("R%d", i)), result.typ.Type())
-										})
-									}
-								})
-							}
-						})
+						g.Id(mock.field("History")).Index().Add(historyStructCode(mock, method))
 					}
 					if len(method.params) > 0 {
 						g.Comment("params")
-						g.Id("Params").StructFunc(func(g *jen.Group) {
-							for i, param := range method.params {
-								param := param
-								g.Do(func(s *jen.Statement) {
-									typeCode(s.Id(fmt.Sprintf("P%d", i)), param.typ.Type())
-								})
-							}
-						})
+						g.Id(mock.field("Params")).Add(paramsStructCode(method))
 					}
 					if len(method.results) > 0 {
 						g.Comment("results")
-						g.Id("Results").StructFunc(func(g *jen.Group) {
-							for i, result := range method.results {
-								result := result
-								g.Do(func(s *jen.Statement) {
-									typeCode(s.Id(fmt.Sprintf("R%d", i)), result.typ.Type())
-								})
-							}
+						g.Id(mock.field("Results")).Add(resultsStructCode(method))
+						g.Comment("if it is not empty, its first element will be consumed instead of the results.")
+						g.Id(mock.field("ResultsSeq")).Index().Add(resultsStructCode(method))
+					}
+					if mock.withLatency && method.takesContext() {
+						g.Comment("the method sleeps for the delay, and stops sleeping when the context is done if it respects the context.")
+						g.Id(mock.field("Delay")).Qual("time", "Duration")
+						g.Id(mock.field("RespectContext")).Bool()
+					}
+					if mock.withFailures && method.returnsError() {
+						g.Comment("if it is not nil, it'll be the last result after the failAfter calls.")
+						g.Id("failErr").Error()
+						g.Id("failAfter").Int()
+					}
+					if mock.withExpectations {
+						g.Comment("expectations")
+						g.Id("expectations").Index().Op("*").Do(func(s *jen.Statement) {
+							expectationTypeCode(s, mock, method)
 						})
+						g.Id("unexpectedCalls").Index().String()
+					}
+					if mock.withTestingT {
+						g.Comment("if it is true, the method should be called at least once.")
+						g.Id(mock.field("Required")).Bool()
+					}
+					if mock.withTestingT && mock.checksConfiguration(method) {
+						g.Id("unconfiguredCalls").Index().String()
+					}
+					if mock.checksConfiguration(method) {
+						g.Comment("it is true if the results have been set by Set" + method.typ.Name() + "Results, even if they are zero.")
+						g.Id("configured").Bool()
 					}
 					g.Comment("if it is not nil, it'll be called in the middle of the method.")
-					g.Id("Body").Do(func(s *jen.Statement) {
+					g.Id(mock.field("Body")).Do(func(s *jen.Statement) {
 						typeCode(s, method.typ.Type())
 					})
 				})
@@ -87,15 +114,45 @@ func render(pkg *packages.Package, mocks []mockInfo, gogenerate string) ([]byte,
 		})
 
 		if mock.constructor != "" {
-			f.Func().Id(mock.constructor).Params(
-				jen.Id("v").Op("...").Do(func(s *jen.Statement) {
-					typeCode(s, mock.typ)
+			f.Func().Id(mock.constructor).TypesFunc(func(g *jen.Group) {
+				typeParamsCode(g, mock.typeParams)
+			}).ParamsFunc(func(g *jen.Group) {
+				if mock.withTestingT {
+					g.Id("t").Qual("testing", "TB")
+				}
+				if mock.spy {
+					g.Id("delegate").Do(mock.implementedTypeCode)
+				} else {
+					g.Id("v").Op("...").Do(mock.implementedTypeCode)
+				}
+			}).Op("*").Do(func(s *jen.Statement) {
+				mockTypeCode(s, mock)
+			}).Block(
+				jen.Id("m").Op(":=").Op("&").Do(func(s *jen.Statement) {
+					mockTypeCode(s, mock)
+				}).Values(jen.DictFunc(func(d jen.Dict) {
+					if mock.withTestingT {
+						d[jen.Id("t")] = jen.Id("t")
+					}
+					if mock.spy {
+						d[jen.Id("delegate")] = jen.Id("delegate")
+					}
+				})),
+				jen.Do(func(s *jen.Statement) {
+					if mock.spy {
+						return
+					}
+					s.If(jen.Len(jen.Id("v")).Op(">").Lit(0)).BlockFunc(func(g *jen.Group) {
+						for _, method := range mock.methods {
+							g.Id("m").Dot(method.fieldName).Dot(mock.field("Body")).Op("=").Add(mock.delegateMethodCode(jen.Id("v").Index(jen.Lit(0)), method))
+						}
+					})
 				}),
-			).Op("*").Id(mock.name).Block(
-				jen.Id("m").Op(":=").Op("&").Id(mock.name).Values(),
-				jen.If(jen.Len(jen.Id("v")).Op(">").Lit(0)).BlockFunc(func(g *jen.Group) {
-					for _, method := range mock.methods {
-						g.Id("m").Dot(method.fieldName).Dot("Body").Op("=").Id("v").Index(jen.Lit(0)).Dot(method.typ.Name())
+				jen.Do(func(s *jen.Statement) {
+					if mock.withTestingT {
+						s.Id("t").Dot("Cleanup").Call(jen.Func().Params().Block(
+							jen.Id("m").Dot("verify").Call(),
+						))
 					}
 				}),
 				jen.Return(jen.Id("m")),
@@ -103,11 +160,13 @@ func render(pkg *packages.Package, mocks []mockInfo, gogenerate string) ([]byte,
 		}
 
 		for _, method := range mock.methods {
-			f.Func().Params(jen.Id("recv").Op("*").Id(mock.name)).Id(method.typ.Name()).ParamsFunc(func(g *jen.Group) {
-				for i, param := range method.params {
+			f.Func().Params(jen.Id("recv").Op("*").Do(func(s *jen.Statement) {
+				mockTypeCode(s, mock)
+			})).Id(method.typ.Name()).ParamsFunc(func(g *jen.Group) {
+				for _, param := range method.params {
 					param := param
 					g.Do(func(s *jen.Statement) {
-						s.Id(fmt.Sprintf("p%d", i))
+						s.Id(param.name)
 						if param.isVariadic {
 							typeCode(s.Op("..."), param.typ.Type().(*types.Slice).Elem())
 						} else {
@@ -125,74 +184,196 @@ func render(pkg *packages.Package, mocks []mockInfo, gogenerate string) ([]byte,
 			}).BlockFunc(func(g *jen.Group) {
 				fieldName := jen.Id("recv").Dot(method.fieldName)
 
+				if mock.withCallOrder {
+					g.Comment("call order")
+					g.Id("seq").Op(":=").Qual(mockcPath, "NextSeq").Call()
+				}
+
+				// the method sleeps before it records the call, so that the overlapping calls don't overwrite each other's state.
+				if mock.withLatency && method.takesContext() {
+					g.Comment("latency")
+					g.Add(fieldName).Dot("mu").Dot("Lock").Call()
+					g.List(jen.Id("delay"), jen.Id("respectContext")).Op(":=").List(jen.Add(fieldName).Dot(mock.field("Delay")), jen.Add(fieldName).Dot(mock.field("RespectContext")))
+					g.Add(fieldName).Dot("mu").Dot("Unlock").Call()
+					g.Id("ctxErr").Op(":=").Qual(mockcPath, "Sleep").Call(jen.Id(method.params[0].name), jen.Id("delay"), jen.Id("respectContext"))
+				}
+
 				g.Add(fieldName).Dot("mu").Dot("Lock").Call()
 				g.Defer().Add(fieldName).Dot("mu").Dot("Unlock").Call()
 
 				g.Comment("basics")
-				g.Add(fieldName).Dot("Called").Op("=").True()
-				g.Add(fieldName).Dot("CallCount").Op("++")
+				g.Add(fieldName).Dot(mock.field("Called")).Op("=").True()
+				g.Add(fieldName).Dot(mock.field("CallCount")).Op("++")
+				if mock.withWaiters {
+					g.If(jen.Add(fieldName).Dot("notify").Op("!=").Nil()).Block(
+						jen.Close(jen.Add(fieldName).Dot("notify")),
+						jen.Add(fieldName).Dot("notify").Op("=").Nil(),
+					)
+				}
 
 				if len(method.params) > 0 {
 					g.Comment("params")
-					for i := range method.params {
-						g.Add(fieldName).Dot("Params").Dot(fmt.Sprintf("P%d", i)).Op("=").Id(fmt.Sprintf("p%d", i))
+					for _, param := range method.params {
+						g.Add(fieldName).Dot(mock.field("Params")).Dot(param.fieldName).Op("=").Id(param.name)
+					}
+				}
+
+				if len(method.results) > 0 {
+					g.Comment("default results")
+					g.Id("results").Op(":=").Add(fieldName).Dot(mock.field("Results"))
+					if mock.checksConfiguration(method) {
+						g.Id("configured").Op(":=").Add(fieldName).Dot("configured").Op("||").Op("!").Qual("reflect", "ValueOf").Call(jen.Id("results")).Dot("IsZero").Call()
+					}
+					if mock.tracksSupplied(method) {
+						g.Id("supplied").Op(":=").False()
 					}
 				}
 
+				if mock.withExpectations {
+					g.Comment("expectations")
+					expectationsCode(g, mock, method)
+				}
+
+				// the entry of the results sequence is consumed only if neither the expectation nor the body supplies the results.
+				if len(method.results) > 0 {
+					g.Comment("results sequence")
+					g.If(jen.Do(func(s *jen.Statement) {
+						if mock.withExpectations {
+							s.Op("!").Id("supplied").Op("&&")
+						}
+					}).Add(fieldName).Dot(mock.field("Body")).Op("==").Nil().Op("&&").Len(jen.Add(fieldName).Dot(mock.field("ResultsSeq"))).Op(">").Lit(0)).BlockFunc(func(g *jen.Group) {
+						g.Id("results").Op("=").Add(fieldName).Dot(mock.field("ResultsSeq")).Index(jen.Lit(0))
+						g.Add(fieldName).Dot(mock.field("ResultsSeq")).Op("=").Add(fieldName).Dot(mock.field("ResultsSeq")).Index(jen.Lit(1).Op(":"))
+						if mock.checksConfiguration(method) {
+							g.Id("configured").Op("=").True()
+						}
+						if mock.spy {
+							g.Id("supplied").Op("=").True()
+						}
+					})
+				}
+
+				if mock.checksConfiguration(method) {
+					g.Comment("unconfigured calls")
+					g.If(jen.Op("!").Id("configured").Op("&&").Add(fieldName).Dot(mock.field("Body")).Op("==").Nil().Do(func(s *jen.Statement) {
+						if mock.spy {
+							s.Op("&&").Id("recv").Dot("delegate").Op("==").Nil()
+						}
+						if mock.withRecordReplay {
+							s.Op("&&").Id("recv").Dot("fixture").Op("==").Nil()
+						}
+						if mock.withFailures && method.returnsError() {
+							s.Op("&&").Add(fieldName).Dot("failErr").Op("==").Nil()
+						}
+						if mock.withLatency && method.takesContext() {
+							s.Op("&&").Id("ctxErr").Op("==").Nil()
+						}
+						if mock.withExpectations {
+							s.Op("&&").Op("!").Id("matched")
+						}
+					})).BlockFunc(func(g *jen.Group) {
+						switch {
+						case mock.strict && mock.withTestingT:
+							g.If(jen.Id("recv").Dot("t").Op("!=").Nil()).Block(
+								jen.Id("recv").Dot("t").Dot("Helper").Call(),
+								jen.Id("recv").Dot("t").Dot("Error").Call(callCode(method, mock.name+".", ": called without configured behavior")),
+							).Else().Block(
+								jen.Panic(callCode(method, mock.name+".", ": called without configured behavior")),
+							)
+						case mock.strict:
+							g.Panic(callCode(method, mock.name+".", ": called without configured behavior"))
+						default:
+							g.Add(fieldName).Dot("unconfiguredCalls").Op("=").Append(jen.Add(fieldName).Dot("unconfiguredCalls"), callCode(method, "", ""))
+						}
+					})
+				}
+
 				g.Comment("body")
-				g.If(jen.Add(fieldName).Dot("Body").Op("!=").Nil()).BlockFunc(func(g *jen.Group) {
-					g.Do(func(s *jen.Statement) {
+				g.Do(func(s *jen.Statement) {
+					if !mock.withLatency || !method.takesContext() {
+						return
+					}
+					s.If(jen.Id("ctxErr").Op("!=").Nil()).BlockFunc(func(g *jen.Group) {
 						if len(method.results) > 0 {
-							s.ListFunc(func(g *jen.Group) {
-								for i := range method.results {
-									g.Add(fieldName).Dot("Results").Dot(fmt.Sprintf("R%d", i))
-								}
-							}).Op("=")
+							g.Id("results").Op("=").Add(resultsStructCode(method)).Values()
 						}
-						s.Add(fieldName).Dot("Body").CallFunc(func(g *jen.Group) {
-							for i, param := range method.params {
-								g.Do(func(s *jen.Statement) {
-									s.Id(fmt.Sprintf("p%d", i))
-									if param.isVariadic {
-										s.Op("...")
-									}
-								})
+						if method.returnsError() {
+							g.Id("results").Dot(method.results[len(method.results)-1].fieldName).Op("=").Id("ctxErr")
+						}
+					}).Else()
+				}).If(jen.Add(fieldName).Dot(mock.field("Body")).Op("!=").Nil()).BlockFunc(func(g *jen.Group) {
+					g.Add(bodyCallCode(method, jen.Add(fieldName).Dot(mock.field("Body"))))
+					if len(method.results) > 0 {
+						g.Add(fieldName).Dot(mock.field("Results")).Op("=").Id("results")
+					}
+				}).Do(func(s *jen.Statement) {
+					if mock.withRecordReplay {
+						s.Else().If(jen.Id("recv").Dot("fixture").Op("!=").Nil().Op("&&").Op("!").Id("recv").Dot("fixture").Dot("Recording").Call()).Block(
+							jen.Id("recv").Dot("fixture").Dot("Replay").CallFunc(func(g *jen.Group) {
+								g.Lit(method.typ.Name())
+								g.Add(fixtureParamsCode(method))
+								for _, result := range method.results {
+									g.Op("&").Id("results").Dot(result.fieldName)
+								}
+							}),
+						)
+					}
+					if mock.spy {
+						s.Else().If(jen.Id("recv").Dot("delegate").Op("!=").Nil().Do(func(s *jen.Statement) {
+							if mock.tracksSupplied(method) {
+								s.Op("&&").Op("!").Id("supplied")
 							}
-						})
-					})
+						})).Block(
+							bodyCallCode(method, mock.delegateMethodCode(jen.Id("recv").Dot("delegate"), method)),
+						)
+					}
 				})
 
-				if len(method.params)+len(method.results) > 0 {
-					g.Comment("call history")
-					g.Id("recv").Dot(method.fieldName).Dot("History").Op("=").Append(
-						jen.Id("recv").Dot(method.fieldName).Dot("History"),
-						jen.StructFunc(func(g *jen.Group) {
-							if len(method.params) > 0 {
-								g.Id("Params").StructFunc(func(g *jen.Group) {
-									for i, param := range method.params {
-										param := param
-										g.Do(func(s *jen.Statement) {
-											typeCode(s.Id(fmt.Sprintf("P%d", i)), param.typ.Type())
-										})
-									}
-								})
-							}
-							if len(method.results) > 0 {
-								g.Id("Results").StructFunc(func(g *jen.Group) {
-									for i, result := range method.results {
-										result := result
-										g.Do(func(s *jen.Statement) {
-											typeCode(s.Id(fmt.Sprintf("R%d", i)), result.typ.Type())
-										})
+				if mock.withRecordReplay {
+					g.Comment("record")
+					g.If(jen.Id("recv").Dot("fixture").Op("!=").Nil().Op("&&").Id("recv").Dot("fixture").Dot("Recording").Call()).Block(
+						jen.Id("recv").Dot("fixture").Dot("Record").Call(
+							jen.Lit(method.typ.Name()),
+							fixtureParamsCode(method),
+							jen.Do(func(s *jen.Statement) {
+								if len(method.results) == 0 {
+									s.Nil()
+									return
+								}
+								s.Index().Interface().ValuesFunc(func(g *jen.Group) {
+									for _, result := range method.results {
+										g.Id("results").Dot(result.fieldName)
 									}
 								})
-							}
-						}).Values(jen.DictFunc(func(d jen.Dict) {
+							}),
+						),
+					)
+				}
+
+				if mock.withFailures && method.returnsError() {
+					g.Comment("failure")
+					g.If(jen.Add(fieldName).Dot("failErr").Op("!=").Nil().Op("&&").Add(fieldName).Dot(mock.field("CallCount")).Op(">").Add(fieldName).Dot("failAfter").Do(func(s *jen.Statement) {
+						if mock.withLatency && method.takesContext() {
+							s.Op("&&").Id("ctxErr").Op("==").Nil()
+						}
+					})).Block(
+						jen.Id("results").Dot(method.results[len(method.results)-1].fieldName).Op("=").Add(fieldName).Dot("failErr"),
+					)
+				}
+
+				if mock.hasHistory(method) {
+					g.Comment("call history")
+					g.Id("recv").Dot(method.fieldName).Dot(mock.field("History")).Op("=").Append(
+						jen.Id("recv").Dot(method.fieldName).Dot(mock.field("History")),
+						historyStructCode(mock, method).Values(jen.DictFunc(func(d jen.Dict) {
 							if len(method.params) > 0 {
-								d[jen.Id("Params")] = jen.Add(fieldName).Dot("Params")
+								d[jen.Id("Params")] = jen.Add(fieldName).Dot(mock.field("Params"))
 							}
 							if len(method.results) > 0 {
-								d[jen.Id("Results")] = jen.Add(fieldName).Dot("Results")
+								d[jen.Id("Results")] = jen.Id("results")
+							}
+							if mock.withCallOrder {
+								d[jen.Id("Seq")] = jen.Id("seq")
 							}
 						})),
 					)
@@ -201,13 +382,92 @@ func render(pkg *packages.Package, mocks []mockInfo, gogenerate string) ([]byte,
 				if len(method.results) > 0 {
 					g.Comment("results")
 					g.ReturnFunc(func(g *jen.Group) {
-						for i := range method.results {
-							g.Add(fieldName).Dot("Results").Dot(fmt.Sprintf("R%d", i))
+						for _, result := range method.results {
+							g.Id("results").Dot(result.fieldName)
 						}
 					})
 				}
 			}).Line()
 		}
+
+		if mock.funcType != nil {
+			f.Func().Params(jen.Id("recv").Op("*").Do(func(s *jen.Statement) {
+				mockTypeCode(s, mock)
+			})).Id("Func").Params().Do(mock.implementedTypeCode).Block(
+				jen.Return(jen.Id("recv").Dot(mock.methods[0].typ.Name())),
+			).Line()
+		}
+
+		if mock.withExpectations {
+			renderExpectations(f, mock)
+		}
+
+		if mock.withCallOrder {
+			renderCalls(f, mock)
+		}
+
+		if mock.spy {
+			renderSpy(f, mock)
+		}
+
+		if mock.withMatchers {
+			renderMatchers(f, mock)
+		}
+
+		if mock.withAccessors || mock.hideFields {
+			renderAccessors(f, mock)
+		} else {
+			for _, method := range mock.methods {
+				if mock.checksConfiguration(method) {
+					renderSetResults(f, mock, method)
+				}
+			}
+		}
+
+		if mock.withWaiters {
+			renderWaiters(f, mock)
+		}
+
+		if mock.withReset {
+			renderReset(f, mock)
+		}
+
+		if mock.withFailures {
+			renderFailures(f, mock)
+		}
+
+		if mock.withTranscript {
+			renderTranscript(f, mock)
+		}
+
+		if mock.withRecordReplay {
+			renderFixture(f, mock)
+		}
+
+		if mock.withTestingT {
+			f.Func().Params(jen.Id("recv").Op("*").Do(func(s *jen.Statement) {
+				mockTypeCode(s, mock)
+			})).Id("verify").Params().BlockFunc(func(g *jen.Group) {
+				g.Id("recv").Dot("t").Dot("Helper").Call()
+				for _, method := range mock.methods {
+					fieldName := jen.Id("recv").Dot(method.fieldName)
+
+					g.Add(fieldName).Dot("mu").Dot("Lock").Call()
+					g.If(jen.Add(fieldName).Dot(mock.field("Required")).Op("&&").Op("!").Add(fieldName).Dot(mock.field("Called"))).Block(
+						jen.Id("recv").Dot("t").Dot("Errorf").Call(jen.Lit(fmt.Sprintf("%s.%s: required but never called", mock.name, method.typ.Name()))),
+					)
+					if mock.checksConfiguration(method) {
+						g.For(jen.List(jen.Id("_"), jen.Id("call")).Op(":=").Range().Add(fieldName).Dot("unconfiguredCalls")).Block(
+							jen.Id("recv").Dot("t").Dot("Errorf").Call(jen.Lit(mock.name+".%s: called without configured behavior"), jen.Id("call")),
+						)
+					}
+					g.Add(fieldName).Dot("mu").Dot("Unlock").Call()
+				}
+				if mock.withExpectations {
+					g.Id("recv").Dot("AssertExpectations").Call(jen.Id("recv").Dot("t"))
+				}
+			}).Line()
+		}
 	}
 
 	b := bytes.NewBuffer(nil)
@@ -289,35 +549,16 @@ func typeCode(stmt *jen.Statement, t types.Type) jen.Code {
 		return typeCode(stmt.Op("*"), t.Elem())
 	case *types.Tuple:
 		return stmt.ValuesFunc(func(g *jen.Group) {
-			typeTupleCode(g, t, false)
+			typeTupleCode(g, t, false, false)
 		})
 	case *types.Signature:
 		return stmt.Func().ParamsFunc(func(g *jen.Group) {
-			typeTupleCode(g, t.Params(), t.Variadic())
+			typeTupleCode(g, t.Params(), t.Variadic(), false)
 		}).ParamsFunc(func(g *jen.Group) {
-			typeTupleCode(g, t.Results(), false)
+			typeTupleCode(g, t.Results(), false, false)
 		})
 	case *types.Interface:
-		return stmt.InterfaceFunc(func(g *jen.Group) {
-			for i := 0; i < t.NumEmbeddeds(); i++ {
-				e := t.EmbeddedType(i)
-				g.Do(func(s *jen.Statement) {
-					typeCode(s, e)
-				})
-			}
-			for i := 0; i < t.NumExplicitMethods(); i++ {
-				m := t.ExplicitMethod(i)
-				sig := m.Type().(*types.Signature)
-
-				g.Do(func(s *jen.Statement) {
-					s.Id(m.Name()).ParamsFunc(func(g *jen.Group) {
-						typeTupleCode(g, sig.Params(), sig.Variadic())
-					}).ParamsFunc(func(g *jen.Group) {
-						typeTupleCode(g, sig.Results(), false)
-					})
-				})
-			}
-		})
+		return interfaceTypeCode(stmt, t, false)
 	case *types.Map:
 		return typeCode(stmt.Map(typeCode(nil, t.Key())), t.Elem())
 	case *types.Chan:
@@ -330,32 +571,260 @@ func typeCode(stmt *jen.Statement, t types.Type) jen.Code {
 			return typeCode(stmt.Chan().Op("<-"), t.Elem())
 		}
 	case *types.Named:
-		if i := strings.LastIndex(t.String(), "."); i >= 0 {
-			return stmt.Qual(t.String()[:i], t.String()[i+1:])
+		if t.Obj().Pkg() == nil {
+			stmt.Id(t.Obj().Name())
+		} else {
+			stmt.Qual(t.Obj().Pkg().Path(), t.Obj().Name())
+		}
+		if t.TypeArgs().Len() > 0 {
+			stmt.TypesFunc(func(g *jen.Group) {
+				typeListCode(g, t.TypeArgs())
+			})
+		}
+		return stmt
+	case *types.Alias:
+		if t.Obj().Pkg() == nil {
+			stmt.Id(t.Obj().Name())
+		} else {
+			stmt.Qual(t.Obj().Pkg().Path(), t.Obj().Name())
+		}
+		if t.TypeArgs().Len() > 0 {
+			stmt.TypesFunc(func(g *jen.Group) {
+				typeListCode(g, t.TypeArgs())
+			})
+		}
+		return stmt
+	case *types.TypeParam:
+		return stmt.Id(t.Obj().Name())
+	case *types.Union:
+		return stmt.UnionFunc(func(g *jen.Group) {
+			for i := 0; i < t.Len(); i++ {
+				term := t.Term(i)
+				g.Do(func(s *jen.Statement) {
+					if term.Tilde() {
+						s.Op("~")
+					}
+					typeCode(s, term.Type())
+				})
+			}
+		})
+	}
+	return stmt
+}
+
+// interfaceTypeCode renders the interface type.
+// If names is true, the declared names of the params and results of the explicit methods are kept.
+func interfaceTypeCode(stmt *jen.Statement, t *types.Interface, names bool) jen.Code {
+	return stmt.InterfaceFunc(func(g *jen.Group) {
+		for i := 0; i < t.NumEmbeddeds(); i++ {
+			e := t.EmbeddedType(i)
+			g.Do(func(s *jen.Statement) {
+				typeCode(s, e)
+			})
+		}
+		for i := 0; i < t.NumExplicitMethods(); i++ {
+			m := t.ExplicitMethod(i)
+			sig := m.Type().(*types.Signature)
+
+			g.Do(func(s *jen.Statement) {
+				s.Id(m.Name()).ParamsFunc(func(g *jen.Group) {
+					typeTupleCode(g, sig.Params(), sig.Variadic(), names)
+				}).ParamsFunc(func(g *jen.Group) {
+					typeTupleCode(g, sig.Results(), false, names)
+				})
+			})
+		}
+	})
+}
+
+// bodyCallCode renders the call of the method's body, which stores its results into the local results.
+func bodyCallCode(method methodInfo, callee *jen.Statement) *jen.Statement {
+	return jen.Do(func(s *jen.Statement) {
+		if len(method.results) > 0 {
+			s.ListFunc(func(g *jen.Group) {
+				for _, result := range method.results {
+					g.Id("results").Dot(result.fieldName)
+				}
+			}).Op("=")
+		}
+		s.Add(callee).CallFunc(func(g *jen.Group) {
+			for _, param := range method.params {
+				param := param
+				g.Do(func(s *jen.Statement) {
+					s.Id(param.name)
+					if param.isVariadic {
+						s.Op("...")
+					}
+				})
+			}
+		})
+	})
+}
+
+// callCode renders the description of the method call like Get("key") surrounded by the given prefix and suffix.
+// The params are formatted at runtime by mockc.FormatCall, since they can be of any type including functions.
+func callCode(method methodInfo, prefix string, suffix string) *jen.Statement {
+	stmt := jen.Qual(mockcPath, "FormatCall").CallFunc(func(g *jen.Group) {
+		g.Lit(prefix + method.typ.Name())
+		for _, param := range method.params {
+			g.Id(param.name)
+		}
+	})
+	if suffix != "" {
+		stmt.Op("+").Lit(suffix)
+	}
+
+	return stmt
+}
+
+func paramsStructCode(method methodInfo) *jen.Statement {
+	return jen.StructFunc(func(g *jen.Group) {
+		for _, param := range method.params {
+			param := param
+			g.Do(func(s *jen.Statement) {
+				typeCode(s.Id(param.fieldName), param.typ.Type())
+			})
+		}
+	})
+}
+
+func resultsStructCode(method methodInfo) *jen.Statement {
+	return jen.StructFunc(func(g *jen.Group) {
+		for _, result := range method.results {
+			result := result
+			g.Do(func(s *jen.Statement) {
+				typeCode(s.Id(result.fieldName), result.typ.Type())
+			})
+		}
+	})
+}
+
+func historyStructCode(mock mockInfo, method methodInfo) *jen.Statement {
+	return jen.StructFunc(func(g *jen.Group) {
+		if len(method.params) > 0 {
+			g.Id("Params").Add(paramsStructCode(method))
+		}
+		if len(method.results) > 0 {
+			g.Id("Results").Add(resultsStructCode(method))
+		}
+		if mock.withCallOrder {
+			g.Id("Seq").Uint64()
 		}
-		return stmt.Id(t.String())
+	})
+}
+
+func mockTypeCode(stmt *jen.Statement, mock mockInfo) jen.Code {
+	return genericTypeCode(stmt, mock.name, mock.typeParams)
+}
+
+func genericTypeCode(stmt *jen.Statement, name string, typeParams *types.TypeParamList) jen.Code {
+	stmt.Id(name)
+	if typeParams.Len() > 0 {
+		stmt.TypesFunc(func(g *jen.Group) {
+			for i := 0; i < typeParams.Len(); i++ {
+				g.Id(typeParams.At(i).Obj().Name())
+			}
+		})
 	}
 	return stmt
 }
 
-func typeTupleCode(g *jen.Group, t *types.Tuple, variadic bool) {
+func typeListCode(g *jen.Group, l *types.TypeList) {
+	for i := 0; i < l.Len(); i++ {
+		t := l.At(i)
+		g.Do(func(s *jen.Statement) {
+			typeCode(s, t)
+		})
+	}
+}
+
+func typeParamsCode(g *jen.Group, l *types.TypeParamList) {
+	for i := 0; i < l.Len(); i++ {
+		tp := l.At(i)
+		g.Do(func(s *jen.Statement) {
+			constraint := tp.Constraint()
+			if iface, ok := constraint.(*types.Interface); ok && iface.IsImplicit() {
+				constraint = iface.EmbeddedType(0)
+			}
+			typeCode(s.Id(tp.Obj().Name()), constraint)
+		})
+	}
+}
+
+// typeTupleCode renders the types of the tuple, and their declared names if names is true.
+func typeTupleCode(g *jen.Group, t *types.Tuple, variadic bool, names bool) {
 	for i := 0; i < t.Len(); i++ {
 		g.Do(func(s *jen.Statement) {
 			v := t.At(i)
+			name := ""
+			if names {
+				name = v.Name()
+			}
 			if variadic && i+1 == t.Len() {
-				typeCode(s.Id("").Op("..."), v.Type().(*types.Slice).Elem())
+				typeCode(s.Id(name).Op("..."), v.Type().(*types.Slice).Elem())
 			} else {
-				typeCode(s.Id(""), v.Type())
+				typeCode(s.Id(name), v.Type())
 			}
 		})
 	}
 }
 
+type interfaceInfo struct {
+	name string
+	typ  *types.Interface
+}
+
 type mockInfo struct {
-	typ         *types.Interface
-	name        string
-	constructor string
-	methods     []methodInfo
+	mockOptions
+	typ        *types.Interface
+	name       string
+	typeParams *types.TypeParamList
+	methods    []methodInfo
+	// funcType is the function type implemented by the mock, or nil if the mock implements the interfaces.
+	funcType types.Type
+}
+
+// implementedTypeCode renders the type implemented by the mock.
+func (m mockInfo) implementedTypeCode(s *jen.Statement) {
+	if m.funcType != nil {
+		typeCode(s, m.funcType)
+		return
+	}
+	typeCode(s, m.typ)
+}
+
+// delegateMethodCode renders the method of the delegate, which has the type implemented by the mock.
+func (m mockInfo) delegateMethodCode(delegate *jen.Statement, method methodInfo) *jen.Statement {
+	if m.funcType != nil {
+		return delegate
+	}
+
+	return delegate.Dot(method.typ.Name())
+}
+
+// field returns the name of the field of the method's state, which is unexported if the fields are hidden.
+func (m mockInfo) field(name string) string {
+	if m.hideFields {
+		return strings.ToLower(name[:1]) + name[1:]
+	}
+
+	return name
+}
+
+// checksConfiguration reports whether the calls of the method fail without configured behavior.
+// The methods without results are always regarded as configured.
+func (m mockInfo) checksConfiguration(method methodInfo) bool {
+	return (m.withTestingT || m.strict) && len(method.results) > 0
+}
+
+// tracksSupplied reports whether the method tracks if its results are supplied by the expectations or the results sequence,
+// which take precedence over the delegate and keep the results sequence from being consumed.
+func (m mockInfo) tracksSupplied(method methodInfo) bool {
+	return len(method.results) > 0 && (m.spy || m.withExpectations)
+}
+
+func (m mockInfo) hasHistory(method methodInfo) bool {
+	return len(method.params)+len(method.results) > 0 || m.withCallOrder
 }
 
 type methodInfo struct {
@@ -365,11 +834,56 @@ type methodInfo struct {
 	results   []resultInfo
 }
 
+// takesContext reports whether the first param of the method is context.Context.
+func (m methodInfo) takesContext() bool {
+	if len(m.params) == 0 {
+		return false
+	}
+
+	named, ok := m.params[0].typ.Type().(*types.Named)
+
+	return ok && named.Obj().Pkg() != nil && named.Obj().Pkg().Path() == "context" && named.Obj().Name() == "Context"
+}
+
+// returnsError reports whether the last result of the method is error.
+func (m methodInfo) returnsError() bool {
+	if len(m.results) == 0 {
+		return false
+	}
+
+	return types.Identical(m.results[len(m.results)-1].typ.Type(), types.Universe.Lookup("error").Type())
+}
+
+// expectationMethodNames returns the names of the methods generated for the method's expectation type.
+func (m methodInfo) expectationMethodNames() []string {
+	names := []string{"Times", "match", "describe"}
+	if len(m.results) > 0 {
+		names = append(names, "Return")
+	}
+	for _, param := range m.params {
+		if !param.isFunc() {
+			names = append(names, "With"+param.fieldName)
+		}
+		names = append(names, "With"+param.fieldName+"Func")
+	}
+
+	return names
+}
+
 type paramInfo struct {
 	typ        *types.Var
+	name       string
+	fieldName  string
 	isVariadic bool
 }
 
+// isFunc reports whether the param is a function.
+func (p paramInfo) isFunc() bool {
+	_, ok := p.typ.Type().Underlying().(*types.Signature)
+	return ok
+}
+
 type resultInfo struct {
-	typ *types.Var
+	typ       *types.Var
+	fieldName string
 }
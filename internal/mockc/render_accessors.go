@@ -0,0 +1,122 @@
+package mockc
+
+import (
+	"fmt"
+
+	"github.com/dave/jennifer/jen"
+)
+
+// renderAccessors renders the methods that access the state of the mock's methods with their locks held.
+func renderAccessors(f *jen.File, mock mockInfo) {
+	for _, method := range mock.methods {
+		method := method
+		fieldName := jen.Id("recv").Dot(method.fieldName)
+		accessor := func(name string) *jen.Statement {
+			return f.Func().Params(jen.Id("recv").Op("*").Do(func(s *jen.Statement) {
+				mockTypeCode(s, mock)
+			})).Id(name)
+		}
+		lock := func(g *jen.Group) {
+			g.Add(fieldName).Dot("mu").Dot("Lock").Call()
+			g.Defer().Add(fieldName).Dot("mu").Dot("Unlock").Call()
+		}
+
+		accessor(method.typ.Name() + "CallCount").Params().Int().BlockFunc(func(g *jen.Group) {
+			lock(g)
+			g.Return(jen.Add(fieldName).Dot(mock.field("CallCount")))
+		}).Line()
+
+		if len(method.params) > 0 {
+			accessor(method.typ.Name() + "Params").Params().Add(paramsStructCode(method)).BlockFunc(func(g *jen.Group) {
+				lock(g)
+				g.Return(jen.Add(fieldName).Dot(mock.field("Params")))
+			}).Line()
+		}
+
+		if mock.hasHistory(method) {
+			history := jen.Add(fieldName).Dot(mock.field("History"))
+			accessor(method.typ.Name() + "History").Params().Index().Add(historyStructCode(mock, method)).BlockFunc(func(g *jen.Group) {
+				lock(g)
+				g.Return(jen.Append(history.Clone().Index(jen.Op(":").Lit(0).Op(":").Lit(0)), history.Clone().Op("...")))
+			}).Line()
+		}
+
+		if len(method.results) > 0 {
+			resultParams := func(g *jen.Group) {
+				for i, result := range method.results {
+					result := result
+					g.Do(func(s *jen.Statement) {
+						typeCode(s.Id(fmt.Sprintf("r%d", i)), result.typ.Type())
+					})
+				}
+			}
+			resultValues := resultsStructCode(method).Values(jen.DictFunc(func(d jen.Dict) {
+				for i, result := range method.results {
+					d[jen.Id(result.fieldName)] = jen.Id(fmt.Sprintf("r%d", i))
+				}
+			}))
+
+			renderSetResults(f, mock, method)
+
+			accessor("Append" + method.typ.Name() + "Results").ParamsFunc(resultParams).BlockFunc(func(g *jen.Group) {
+				lock(g)
+				g.Add(fieldName).Dot(mock.field("ResultsSeq")).Op("=").Append(jen.Add(fieldName).Dot(mock.field("ResultsSeq")), resultValues.Clone())
+			}).Line()
+		}
+
+		accessor("Set" + method.typ.Name() + "Body").Params(jen.Id("body").Do(func(s *jen.Statement) {
+			typeCode(s, method.typ.Type())
+		})).BlockFunc(func(g *jen.Group) {
+			lock(g)
+			g.Add(fieldName).Dot(mock.field("Body")).Op("=").Id("body")
+		}).Line()
+
+		if mock.withLatency && method.takesContext() {
+			accessor("Set" + method.typ.Name() + "Delay").Params(jen.Id("d").Qual("time", "Duration")).BlockFunc(func(g *jen.Group) {
+				lock(g)
+				g.Add(fieldName).Dot(mock.field("Delay")).Op("=").Id("d")
+			}).Line()
+
+			accessor("Set" + method.typ.Name() + "RespectContext").Params(jen.Id("v").Bool()).BlockFunc(func(g *jen.Group) {
+				lock(g)
+				g.Add(fieldName).Dot(mock.field("RespectContext")).Op("=").Id("v")
+			}).Line()
+		}
+
+		if mock.withTestingT {
+			accessor("Require" + method.typ.Name()).Params().BlockFunc(func(g *jen.Group) {
+				lock(g)
+				g.Add(fieldName).Dot(mock.field("Required")).Op("=").True()
+			}).Line()
+		}
+	}
+}
+
+// renderSetResults renders the Set{METHOD}Results method, which also marks the results as configured.
+// It's rendered without the accessors if the calls of the method fail without configured behavior,
+// so that the zero results can be configured.
+func renderSetResults(f *jen.File, mock mockInfo, method methodInfo) {
+	fieldName := jen.Id("recv").Dot(method.fieldName)
+
+	f.Func().Params(jen.Id("recv").Op("*").Do(func(s *jen.Statement) {
+		mockTypeCode(s, mock)
+	})).Id("Set" + method.typ.Name() + "Results").ParamsFunc(func(g *jen.Group) {
+		for i, result := range method.results {
+			result := result
+			g.Do(func(s *jen.Statement) {
+				typeCode(s.Id(fmt.Sprintf("r%d", i)), result.typ.Type())
+			})
+		}
+	}).BlockFunc(func(g *jen.Group) {
+		g.Add(fieldName).Dot("mu").Dot("Lock").Call()
+		g.Defer().Add(fieldName).Dot("mu").Dot("Unlock").Call()
+		g.Add(fieldName).Dot(mock.field("Results")).Op("=").Add(resultsStructCode(method)).Values(jen.DictFunc(func(d jen.Dict) {
+			for i, result := range method.results {
+				d[jen.Id(result.fieldName)] = jen.Id(fmt.Sprintf("r%d", i))
+			}
+		}))
+		if mock.checksConfiguration(method) {
+			g.Add(fieldName).Dot("configured").Op("=").True()
+		}
+	}).Line()
+}
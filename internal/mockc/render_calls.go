@@ -0,0 +1,46 @@
+package mockc
+
+import (
+	"github.com/dave/jennifer/jen"
+)
+
+// renderCalls renders the type for getting the calls of the mock with their sequence numbers.
+func renderCalls(f *jen.File, mock mockInfo) {
+	callsType := func(s *jen.Statement) {
+		genericTypeCode(s, mock.name+"Calls", mock.typeParams)
+	}
+
+	f.Type().Id(mock.name + "Calls").TypesFunc(func(g *jen.Group) {
+		typeParamsCode(g, mock.typeParams)
+	}).Struct(
+		jen.Id("m").Op("*").Do(func(s *jen.Statement) {
+			mockTypeCode(s, mock)
+		}),
+	)
+
+	f.Func().Params(jen.Id("recv").Op("*").Do(func(s *jen.Statement) {
+		mockTypeCode(s, mock)
+	})).Id("Calls").Params().Do(callsType).Block(
+		jen.Return(jen.Do(callsType).Values(jen.Dict{
+			jen.Id("m"): jen.Id("recv"),
+		})),
+	).Line()
+
+	for _, method := range mock.methods {
+		fieldName := jen.Id("c").Dot("m").Dot(method.fieldName)
+
+		f.Func().Params(jen.Id("c").Do(callsType)).Id(method.typ.Name()).Params(jen.Id("i").Int()).Qual(mockcPath, "Call").Block(
+			jen.Add(fieldName).Dot("mu").Dot("Lock").Call(),
+			jen.Defer().Add(fieldName).Dot("mu").Dot("Unlock").Call(),
+			jen.Id("call").Op(":=").Qual(mockcPath, "Call").Values(jen.Dict{
+				jen.Id("Mock"):   jen.Lit(mock.name),
+				jen.Id("Method"): jen.Lit(method.typ.Name()),
+				jen.Id("Index"):  jen.Id("i"),
+			}),
+			jen.If(jen.Id("i").Op(">=").Lit(0).Op("&&").Id("i").Op("<").Len(jen.Add(fieldName).Dot(mock.field("History")))).Block(
+				jen.Id("call").Dot("Seq").Op("=").Add(fieldName).Dot(mock.field("History")).Index(jen.Id("i")).Dot("Seq"),
+			),
+			jen.Return(jen.Id("call")),
+		).Line()
+	}
+}
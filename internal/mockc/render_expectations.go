@@ -0,0 +1,197 @@
+package mockc
+
+import (
+	"fmt"
+
+	"github.com/dave/jennifer/jen"
+)
+
+func expectationTypeCode(stmt *jen.Statement, mock mockInfo, method methodInfo) jen.Code {
+	return genericTypeCode(stmt, mock.name+method.typ.Name()+"Expectation", mock.typeParams)
+}
+
+// expectationsCode renders the part of the mock's method that finds the matched expectation.
+func expectationsCode(g *jen.Group, mock mockInfo, method methodInfo) {
+	fieldName := jen.Id("recv").Dot(method.fieldName)
+
+	g.Id("matched").Op(":=").False()
+	g.For(jen.List(jen.Id("_"), jen.Id("expectation")).Op(":=").Range().Add(fieldName).Dot("expectations")).BlockFunc(func(g *jen.Group) {
+		g.If(jen.Op("!").Id("expectation").Dot("match").CallFunc(func(g *jen.Group) {
+			for _, param := range method.params {
+				g.Id(param.name)
+			}
+		})).Block(
+			jen.Continue(),
+		)
+		g.Id("matched").Op("=").True()
+		g.Id("expectation").Dot("calls").Op("++")
+		if len(method.results) > 0 {
+			g.If(jen.Id("expectation").Dot("returns")).Block(
+				jen.Id("results").Op("=").Id("expectation").Dot("results"),
+				jen.Id("supplied").Op("=").True(),
+			)
+		}
+		g.Break()
+	})
+	g.If(jen.Op("!").Id("matched")).Block(
+		jen.Add(fieldName).Dot("unexpectedCalls").Op("=").Append(
+			jen.Add(fieldName).Dot("unexpectedCalls"),
+			callCode(method, "", ""),
+		),
+	)
+}
+
+// renderExpectations renders the expectation types of the mock's methods,
+// and the mock's methods for declaring and asserting the expectations.
+func renderExpectations(f *jen.File, mock mockInfo) {
+	for _, method := range mock.methods {
+		method := method
+
+		expectationType := func(s *jen.Statement) {
+			expectationTypeCode(s, mock, method)
+		}
+		recv := jen.Id("e").Op("*").Do(expectationType)
+
+		f.Type().Id(mock.name + method.typ.Name() + "Expectation").TypesFunc(func(g *jen.Group) {
+			typeParamsCode(g, mock.typeParams)
+		}).StructFunc(func(g *jen.Group) {
+			if len(method.params) > 0 {
+				g.Id("matchers").StructFunc(func(g *jen.Group) {
+					for _, param := range method.params {
+						g.Id(param.fieldName).Func().Params(typeCode(nil, param.typ.Type())).Bool()
+					}
+				})
+				g.Id("descriptions").Index(jen.Lit(len(method.params))).String()
+			}
+			if len(method.results) > 0 {
+				g.Id("returns").Bool()
+				g.Id("results").Add(resultsStructCode(method))
+			}
+			g.Comment("it is -1 if the method is expected to be called at least once.")
+			g.Id("times").Int()
+			g.Id("calls").Int()
+		})
+
+		f.Func().Params(jen.Id("recv").Op("*").Do(func(s *jen.Statement) {
+			mockTypeCode(s, mock)
+		})).Id("Expect"+method.typ.Name()).Params().Op("*").Do(expectationType).Block(
+			jen.Id("recv").Dot(method.fieldName).Dot("mu").Dot("Lock").Call(),
+			jen.Defer().Id("recv").Dot(method.fieldName).Dot("mu").Dot("Unlock").Call(),
+			jen.Id("e").Op(":=").Op("&").Do(expectationType).Values(jen.Dict{
+				jen.Id("times"): jen.Lit(-1),
+			}),
+			jen.Id("recv").Dot(method.fieldName).Dot("expectations").Op("=").Append(
+				jen.Id("recv").Dot(method.fieldName).Dot("expectations"),
+				jen.Id("e"),
+			),
+			jen.Return(jen.Id("e")),
+		).Line()
+
+		for i, param := range method.params {
+			// functions are never deeply equal unless they are nil, so they can be matched only by With<Param>Func.
+			if !param.isFunc() {
+				f.Func().Params(recv.Clone()).Id("With"+param.fieldName).Params(
+					typeCode(jen.Id("v"), param.typ.Type()),
+				).Op("*").Do(expectationType).Block(
+					jen.Id("e").Dot("matchers").Dot(param.fieldName).Op("=").Func().Params(
+						typeCode(jen.Id("actual"), param.typ.Type()),
+					).Bool().Block(
+						jen.Return(jen.Qual("reflect", "DeepEqual").Call(jen.Id("actual"), jen.Id("v"))),
+					),
+					jen.Id("e").Dot("descriptions").Index(jen.Lit(i)).Op("=").Qual(mockcPath, "FormatValue").Call(jen.Id("v")),
+					jen.Return(jen.Id("e")),
+				).Line()
+			}
+
+			f.Func().Params(recv.Clone()).Id("With"+param.fieldName+"Func").Params(
+				jen.Id("match").Func().Params(typeCode(nil, param.typ.Type())).Bool(),
+			).Op("*").Do(expectationType).Block(
+				jen.Id("e").Dot("matchers").Dot(param.fieldName).Op("=").Id("match"),
+				jen.Id("e").Dot("descriptions").Index(jen.Lit(i)).Op("=").Lit("<func>"),
+				jen.Return(jen.Id("e")),
+			).Line()
+		}
+
+		if len(method.results) > 0 {
+			f.Func().Params(recv.Clone()).Id("Return").ParamsFunc(func(g *jen.Group) {
+				for i, result := range method.results {
+					result := result
+					g.Do(func(s *jen.Statement) {
+						typeCode(s.Id(fmt.Sprintf("r%d", i)), result.typ.Type())
+					})
+				}
+			}).Op("*").Do(expectationType).BlockFunc(func(g *jen.Group) {
+				g.Id("e").Dot("returns").Op("=").True()
+				for i, result := range method.results {
+					g.Id("e").Dot("results").Dot(result.fieldName).Op("=").Id(fmt.Sprintf("r%d", i))
+				}
+				g.Return(jen.Id("e"))
+			}).Line()
+		}
+
+		f.Func().Params(recv.Clone()).Id("Times").Params(jen.Id("n").Int()).Op("*").Do(expectationType).Block(
+			jen.If(jen.Id("n").Op("<").Lit(0)).Block(
+				jen.Panic(jen.Qual("fmt", "Sprintf").Call(jen.Lit(mock.name+".%s: Times(%d): the number of calls must not be negative"), jen.Id("e").Dot("describe").Call(), jen.Id("n"))),
+			),
+			jen.Id("e").Dot("times").Op("=").Id("n"),
+			jen.Return(jen.Id("e")),
+		).Line()
+
+		f.Func().Params(recv.Clone()).Id("match").ParamsFunc(func(g *jen.Group) {
+			for i, param := range method.params {
+				param := param
+				g.Do(func(s *jen.Statement) {
+					typeCode(s.Id(fmt.Sprintf("p%d", i)), param.typ.Type())
+				})
+			}
+		}).Bool().BlockFunc(func(g *jen.Group) {
+			g.If(jen.Id("e").Dot("times").Op(">").Lit(0).Op("&&").Id("e").Dot("calls").Op(">=").Id("e").Dot("times")).Block(
+				jen.Return(jen.False()),
+			)
+			for i, param := range method.params {
+				matcher := jen.Id("e").Dot("matchers").Dot(param.fieldName)
+				g.If(matcher.Clone().Op("!=").Nil().Op("&&").Op("!").Add(matcher.Clone()).Call(jen.Id(fmt.Sprintf("p%d", i)))).Block(
+					jen.Return(jen.False()),
+				)
+			}
+			g.Return(jen.True())
+		}).Line()
+
+		f.Func().Params(recv.Clone()).Id("describe").Params().String().BlockFunc(func(g *jen.Group) {
+			if len(method.params) == 0 {
+				g.Return(jen.Lit(method.typ.Name() + "()"))
+				return
+			}
+			g.Id("args").Op(":=").Make(jen.Index().String(), jen.Len(jen.Id("e").Dot("descriptions")))
+			g.For(jen.List(jen.Id("i"), jen.Id("description")).Op(":=").Range().Id("e").Dot("descriptions")).Block(
+				jen.If(jen.Id("description").Op("==").Lit("")).Block(
+					jen.Id("description").Op("=").Lit("_"),
+				),
+				jen.Id("args").Index(jen.Id("i")).Op("=").Id("description"),
+			)
+			g.Return(jen.Lit(method.typ.Name()+"(").Op("+").Qual("strings", "Join").Call(jen.Id("args"), jen.Lit(", ")).Op("+").Lit(")"))
+		}).Line()
+	}
+
+	f.Func().Params(jen.Id("recv").Op("*").Do(func(s *jen.Statement) {
+		mockTypeCode(s, mock)
+	})).Id("AssertExpectations").Params(jen.Id("t").Qual("testing", "TB")).BlockFunc(func(g *jen.Group) {
+		g.Id("t").Dot("Helper").Call()
+		for _, method := range mock.methods {
+			fieldName := jen.Id("recv").Dot(method.fieldName)
+
+			g.Add(fieldName).Dot("mu").Dot("Lock").Call()
+			g.For(jen.List(jen.Id("_"), jen.Id("e")).Op(":=").Range().Add(fieldName).Dot("expectations")).Block(
+				jen.If(jen.Id("e").Dot("times").Op(">=").Lit(0).Op("&&").Id("e").Dot("calls").Op("!=").Id("e").Dot("times")).Block(
+					jen.Id("t").Dot("Errorf").Call(jen.Lit(mock.name+".%s: expected %d call(s), but got %d"), jen.Id("e").Dot("describe").Call(), jen.Id("e").Dot("times"), jen.Id("e").Dot("calls")),
+				).Else().If(jen.Id("e").Dot("times").Op("<").Lit(0).Op("&&").Id("e").Dot("calls").Op("==").Lit(0)).Block(
+					jen.Id("t").Dot("Errorf").Call(jen.Lit(mock.name+".%s: expected at least one call, but got none"), jen.Id("e").Dot("describe").Call()),
+				),
+			)
+			g.For(jen.List(jen.Id("_"), jen.Id("call")).Op(":=").Range().Add(fieldName).Dot("unexpectedCalls")).Block(
+				jen.Id("t").Dot("Errorf").Call(jen.Lit(mock.name+".%s: unexpected call"), jen.Id("call")),
+			)
+			g.Add(fieldName).Dot("mu").Dot("Unlock").Call()
+		}
+	}).Line()
+}
@@ -0,0 +1,49 @@
+package mockc
+
+import (
+	"github.com/dave/jennifer/jen"
+)
+
+// renderFailures renders the methods that inject the panics and the errors into the mock's methods.
+// The errors are only injected into the methods whose last result is error.
+func renderFailures(f *jen.File, mock mockInfo) {
+	f.Func().Params(jen.Id("recv").Op("*").Do(func(s *jen.Statement) {
+		mockTypeCode(s, mock)
+	})).Id("FailAll").Params(jen.Id("err").Error()).BlockFunc(func(g *jen.Group) {
+		for _, method := range mock.methods {
+			if method.returnsError() {
+				g.Id("recv").Dot(method.typ.Name()+"FailsAfter").Call(jen.Lit(0), jen.Id("err"))
+			}
+		}
+	}).Line()
+
+	for _, method := range mock.methods {
+		method := method
+		fieldName := jen.Id("recv").Dot(method.fieldName)
+
+		f.Func().Params(jen.Id("recv").Op("*").Do(func(s *jen.Statement) {
+			mockTypeCode(s, mock)
+		})).Id(method.typ.Name()+"PanicsWith").Params(jen.Id("v").Interface()).Block(
+			jen.Add(fieldName).Dot("mu").Dot("Lock").Call(),
+			jen.Defer().Add(fieldName).Dot("mu").Dot("Unlock").Call(),
+			jen.Add(fieldName).Dot(mock.field("Body")).Op("=").Do(func(s *jen.Statement) {
+				typeCode(s, method.typ.Type())
+			}).Block(
+				jen.Panic(jen.Id("v")),
+			),
+		).Line()
+
+		if !method.returnsError() {
+			continue
+		}
+
+		f.Func().Params(jen.Id("recv").Op("*").Do(func(s *jen.Statement) {
+			mockTypeCode(s, mock)
+		})).Id(method.typ.Name()+"FailsAfter").Params(jen.Id("n").Int(), jen.Id("err").Error()).Block(
+			jen.Add(fieldName).Dot("mu").Dot("Lock").Call(),
+			jen.Defer().Add(fieldName).Dot("mu").Dot("Unlock").Call(),
+			jen.Add(fieldName).Dot("failErr").Op("=").Id("err"),
+			jen.Add(fieldName).Dot("failAfter").Op("=").Add(fieldName).Dot(mock.field("CallCount")).Op("+").Id("n"),
+		).Line()
+	}
+}
@@ -0,0 +1,37 @@
+package mockc
+
+import (
+	"github.com/dave/jennifer/jen"
+)
+
+// fixtureParamsCode renders the params of the mock's method passed to the fixture.
+func fixtureParamsCode(method methodInfo) jen.Code {
+	if len(method.params) == 0 {
+		return jen.Nil()
+	}
+
+	return jen.Index().Interface().ValuesFunc(func(g *jen.Group) {
+		for _, param := range method.params {
+			g.Id(param.name)
+		}
+	})
+}
+
+// renderFixture renders the methods for recording the calls of the mock into the fixture and replaying them.
+func renderFixture(f *jen.File, mock mockInfo) {
+	fixture := func(name string, recording jen.Code) {
+		f.Func().Params(jen.Id("recv").Op("*").Do(func(s *jen.Statement) {
+			mockTypeCode(s, mock)
+		})).Id(name).Params(
+			jen.Id("t").Qual("testing", "TB"),
+			jen.Id("path").String(),
+		).Block(
+			jen.Id("t").Dot("Helper").Call(),
+			jen.Id("recv").Dot("fixture").Op("=").Qual(mockcPath, "NewFixture").Call(jen.Id("t"), jen.Lit(mock.name), jen.Id("path"), recording),
+		).Line()
+	}
+
+	fixture("Record", jen.True())
+	fixture("Replay", jen.False())
+	fixture("UseFixture", jen.Qual(mockcPath, "RecordingFixtures").Call())
+}
@@ -0,0 +1,33 @@
+package mockc
+
+import (
+	"bytes"
+
+	"golang.org/x/tools/go/packages"
+
+	"github.com/dave/jennifer/jen"
+)
+
+// renderInterfaces renders the interfaces written by mockc.WriteInterface.
+// Unlike the mocks, they don't have the build constraints, so the mock generators and the package can refer to them.
+func renderInterfaces(pkg *packages.Package, interfaces []interfaceInfo) ([]byte, error) {
+	f := jen.NewFilePathName(pkg.PkgPath, pkg.Name)
+
+	f.PackageComment("// Code generated by Mockc. DO NOT EDIT.")
+	f.PackageComment("// repo: https://github.com/KimMachineGun/mockc\n")
+
+	for _, inter := range interfaces {
+		// the declared names are kept, so that the mocks of the interface can use them with UseParamNames.
+		f.Type().Id(inter.name).Do(func(s *jen.Statement) {
+			interfaceTypeCode(s, inter.typ, true)
+		}).Line()
+	}
+
+	b := bytes.NewBuffer(nil)
+	err := f.Render(b)
+	if err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
@@ -0,0 +1,40 @@
+package mockc
+
+import (
+	"github.com/dave/jennifer/jen"
+)
+
+const matchPath = mockcPath + "/match"
+
+// renderMatchers renders the methods for finding the recorded calls with the argument matchers.
+// The methods that have no call history are skipped.
+func renderMatchers(f *jen.File, mock mockInfo) {
+	for _, method := range mock.methods {
+		if !mock.hasHistory(method) {
+			continue
+		}
+
+		fieldName := jen.Id("recv").Dot(method.fieldName)
+
+		f.Func().Params(jen.Id("recv").Op("*").Do(func(s *jen.Statement) {
+			mockTypeCode(s, mock)
+		})).Id("Find"+method.typ.Name()+"Calls").Params(
+			jen.Id("matchers").Op("...").Qual(matchPath, "Matcher"),
+		).Index().Add(historyStructCode(mock, method)).Block(
+			jen.Add(fieldName).Dot("mu").Dot("Lock").Call(),
+			jen.Defer().Add(fieldName).Dot("mu").Dot("Unlock").Call(),
+			jen.Var().Id("calls").Index().Add(historyStructCode(mock, method)),
+			jen.For(jen.List(jen.Id("_"), jen.Id("call")).Op(":=").Range().Add(fieldName).Dot(mock.field("History"))).Block(
+				jen.If(jen.Qual(matchPath, "Args").CallFunc(func(g *jen.Group) {
+					g.Id("matchers")
+					for _, param := range method.params {
+						g.Id("call").Dot("Params").Dot(param.fieldName)
+					}
+				})).Block(
+					jen.Id("calls").Op("=").Append(jen.Id("calls"), jen.Id("call")),
+				),
+			),
+			jen.Return(jen.Id("calls")),
+		).Line()
+	}
+}
@@ -0,0 +1,79 @@
+package mockc
+
+import (
+	"github.com/dave/jennifer/jen"
+)
+
+// renderReset renders the methods that clear the recorded calls of the mock.
+// The configured state is cleared unless it is kept by the mockc.ResetOption.
+func renderReset(f *jen.File, mock mockInfo) {
+	f.Func().Params(jen.Id("recv").Op("*").Do(func(s *jen.Statement) {
+		mockTypeCode(s, mock)
+	})).Id("Reset").Params(jen.Id("opts").Op("...").Qual(mockcPath, "ResetOption")).BlockFunc(func(g *jen.Group) {
+		for _, method := range mock.methods {
+			g.Id("recv").Dot("Reset" + method.typ.Name()).Call(jen.Id("opts").Op("..."))
+		}
+	}).Line()
+
+	for _, method := range mock.methods {
+		fieldName := jen.Id("recv").Dot(method.fieldName)
+
+		f.Func().Params(jen.Id("recv").Op("*").Do(func(s *jen.Statement) {
+			mockTypeCode(s, mock)
+		})).Id("Reset" + method.typ.Name()).Params(jen.Id("opts").Op("...").Qual(mockcPath, "ResetOption")).BlockFunc(func(g *jen.Group) {
+			g.Add(fieldName).Dot("mu").Dot("Lock").Call()
+			g.Defer().Add(fieldName).Dot("mu").Dot("Unlock").Call()
+
+			g.Add(fieldName).Dot(mock.field("Called")).Op("=").False()
+			g.Add(fieldName).Dot(mock.field("CallCount")).Op("=").Lit(0)
+			if mock.hasHistory(method) {
+				g.Add(fieldName).Dot(mock.field("History")).Op("=").Nil()
+			}
+			if len(method.params) > 0 {
+				g.Add(fieldName).Dot(mock.field("Params")).Op("=").Add(paramsStructCode(method)).Values()
+			}
+			if mock.withExpectations {
+				g.Add(fieldName).Dot("unexpectedCalls").Op("=").Nil()
+			}
+			if mock.withTestingT && mock.checksConfiguration(method) {
+				g.Add(fieldName).Dot("unconfiguredCalls").Op("=").Nil()
+			}
+
+			g.If(jen.Op("!").Qual(mockcPath, "KeepBody").Dot("Keeps").Call(jen.Id("opts"))).BlockFunc(func(g *jen.Group) {
+				g.Add(fieldName).Dot(mock.field("Body")).Op("=").Nil()
+				if mock.withLatency && method.takesContext() {
+					g.Add(fieldName).Dot(mock.field("Delay")).Op("=").Lit(0)
+					g.Add(fieldName).Dot(mock.field("RespectContext")).Op("=").False()
+				}
+			})
+			if len(method.results) == 0 && !mock.withExpectations {
+				return
+			}
+			failures := mock.withFailures && method.returnsError()
+			g.If(jen.Op("!").Qual(mockcPath, "KeepResults").Dot("Keeps").Call(jen.Id("opts"))).BlockFunc(func(g *jen.Group) {
+				if len(method.results) > 0 {
+					g.Add(fieldName).Dot(mock.field("Results")).Op("=").Add(resultsStructCode(method)).Values()
+					g.Add(fieldName).Dot(mock.field("ResultsSeq")).Op("=").Nil()
+					if mock.checksConfiguration(method) {
+						g.Add(fieldName).Dot("configured").Op("=").False()
+					}
+				}
+				if failures {
+					g.Add(fieldName).Dot("failErr").Op("=").Nil()
+					g.Add(fieldName).Dot("failAfter").Op("=").Lit(0)
+				}
+				if mock.withExpectations {
+					g.Add(fieldName).Dot("expectations").Op("=").Nil()
+				}
+			}).Do(func(s *jen.Statement) {
+				if mock.withExpectations {
+					s.Else().Block(
+						jen.For(jen.List(jen.Id("_"), jen.Id("e")).Op(":=").Range().Add(fieldName).Dot("expectations")).Block(
+							jen.Id("e").Dot("calls").Op("=").Lit(0),
+						),
+					)
+				}
+			})
+		}).Line()
+	}
+}
@@ -0,0 +1,26 @@
+package mockc
+
+import (
+	"github.com/dave/jennifer/jen"
+)
+
+// renderSpy renders the methods for accessing the delegate of the spy and restoring its methods.
+func renderSpy(f *jen.File, mock mockInfo) {
+	f.Func().Params(jen.Id("recv").Op("*").Do(func(s *jen.Statement) {
+		mockTypeCode(s, mock)
+	})).Id("Delegate").Params().Do(mock.implementedTypeCode).Block(
+		jen.Return(jen.Id("recv").Dot("delegate")),
+	).Line()
+
+	for _, method := range mock.methods {
+		fieldName := jen.Id("recv").Dot(method.fieldName)
+
+		f.Func().Params(jen.Id("recv").Op("*").Do(func(s *jen.Statement) {
+			mockTypeCode(s, mock)
+		})).Id("Restore"+method.typ.Name()).Params().Block(
+			jen.Add(fieldName).Dot("mu").Dot("Lock").Call(),
+			jen.Defer().Add(fieldName).Dot("mu").Dot("Unlock").Call(),
+			jen.Add(fieldName).Dot(mock.field("Body")).Op("=").Nil(),
+		).Line()
+	}
+}
@@ -0,0 +1,41 @@
+package mockc
+
+import (
+	"github.com/dave/jennifer/jen"
+)
+
+// renderTranscript renders the method of the mock that collects the recorded calls of all the methods in call order.
+func renderTranscript(f *jen.File, mock mockInfo) {
+	f.Func().Params(jen.Id("recv").Op("*").Do(func(s *jen.Statement) {
+		mockTypeCode(s, mock)
+	})).Id("Transcript").Params().Qual(mockcPath, "Transcript").BlockFunc(func(g *jen.Group) {
+		g.Var().Id("calls").Index().Qual(mockcPath, "TranscriptCall")
+		for _, method := range mock.methods {
+			fieldName := jen.Id("recv").Dot(method.fieldName)
+
+			g.Add(fieldName).Dot("mu").Dot("Lock").Call()
+			g.For(jen.List(jen.Id("_"), jen.Id("h")).Op(":=").Range().Add(fieldName).Dot(mock.field("History"))).Block(
+				jen.Id("calls").Op("=").Append(jen.Id("calls"), jen.Qual(mockcPath, "TranscriptCall").Values(jen.DictFunc(func(d jen.Dict) {
+					d[jen.Id("Seq")] = jen.Id("h").Dot("Seq")
+					d[jen.Id("Method")] = jen.Lit(method.typ.Name())
+					if len(method.params) > 0 {
+						d[jen.Id("Params")] = jen.Index().Interface().ValuesFunc(func(g *jen.Group) {
+							for _, param := range method.params {
+								g.Id("h").Dot("Params").Dot(param.fieldName)
+							}
+						})
+					}
+					if len(method.results) > 0 {
+						d[jen.Id("Results")] = jen.Index().Interface().ValuesFunc(func(g *jen.Group) {
+							for _, result := range method.results {
+								g.Id("h").Dot("Results").Dot(result.fieldName)
+							}
+						})
+					}
+				}))),
+			)
+			g.Add(fieldName).Dot("mu").Dot("Unlock").Call()
+		}
+		g.Return(jen.Qual(mockcPath, "NewTranscript").Call(jen.Lit(mock.name), jen.Id("calls")))
+	}).Line()
+}
@@ -0,0 +1,57 @@
+package mockc
+
+import (
+	"github.com/dave/jennifer/jen"
+)
+
+// renderWaiters renders the methods that block until the mock's methods are called.
+// The notify channel of the method is created by the waiters, and closed by the next call of the method.
+func renderWaiters(f *jen.File, mock mockInfo) {
+	for _, method := range mock.methods {
+		fieldName := jen.Id("recv").Dot(method.fieldName)
+		notify := jen.Add(fieldName).Dot("notify")
+
+		f.Func().Params(jen.Id("recv").Op("*").Do(func(s *jen.Statement) {
+			mockTypeCode(s, mock)
+		})).Id("Wait"+method.typ.Name()).Params(
+			jen.Id("ctx").Qual("context", "Context"),
+			jen.Id("n").Int(),
+		).Error().Block(
+			jen.For().Block(
+				jen.Add(fieldName).Dot("mu").Dot("Lock").Call(),
+				jen.If(jen.Add(fieldName).Dot(mock.field("CallCount")).Op(">=").Id("n")).Block(
+					jen.Add(fieldName).Dot("mu").Dot("Unlock").Call(),
+					jen.Return(jen.Nil()),
+				),
+				jen.If(notify.Clone().Op("==").Nil()).Block(
+					notify.Clone().Op("=").Make(jen.Chan().Struct()),
+				),
+				jen.Id("notify").Op(":=").Add(notify.Clone()),
+				jen.Add(fieldName).Dot("mu").Dot("Unlock").Call(),
+				jen.Line(),
+				jen.Select().Block(
+					jen.Case(jen.Op("<-").Id("notify")),
+					jen.Case(jen.Op("<-").Id("ctx").Dot("Done").Call()).Block(
+						jen.Return(jen.Id("ctx").Dot("Err").Call()),
+					),
+				),
+			),
+		).Line()
+
+		f.Func().Params(jen.Id("recv").Op("*").Do(func(s *jen.Statement) {
+			mockTypeCode(s, mock)
+		})).Id(method.typ.Name()+"Called").Params().Op("<-").Chan().Struct().Block(
+			jen.Add(fieldName).Dot("mu").Dot("Lock").Call(),
+			jen.Defer().Add(fieldName).Dot("mu").Dot("Unlock").Call(),
+			jen.If(jen.Add(fieldName).Dot(mock.field("CallCount")).Op(">").Lit(0)).Block(
+				jen.Id("called").Op(":=").Make(jen.Chan().Struct()),
+				jen.Close(jen.Id("called")),
+				jen.Return(jen.Id("called")),
+			),
+			jen.If(notify.Clone().Op("==").Nil()).Block(
+				notify.Clone().Op("=").Make(jen.Chan().Struct()),
+			),
+			jen.Return(notify.Clone()),
+		).Line()
+	}
+}
@@ -0,0 +1,11 @@
+//+build mockc
+
+package basic
+
+import (
+	"github.com/KimMachineGun/mockc"
+)
+
+func MockcCache() {
+	mockc.Implement(Cache(nil))
+}
@@ -0,0 +1,12 @@
+//+build mockc
+
+package basic
+
+import (
+	"github.com/KimMachineGun/mockc"
+)
+
+func MockcStore() {
+	mockc.ExtractInterface(&Store{})
+	mockc.WriteInterface("StoreInterface")
+}
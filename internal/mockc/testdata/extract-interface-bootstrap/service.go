@@ -0,0 +1,14 @@
+package basic
+
+import (
+	"context"
+)
+
+// Service depends on the interface that doesn't exist until mockc writes it.
+type Service struct {
+	Store StoreInterface
+}
+
+func (s Service) Get(ctx context.Context, key string) (string, error) {
+	return s.Store.Get(ctx, key)
+}
@@ -0,0 +1,24 @@
+//+build mockc
+
+package basic
+
+import (
+	"net/http"
+
+	"github.com/KimMachineGun/mockc"
+)
+
+func MockcStore() {
+	mockc.ExtractInterface(&Store{})
+	mockc.WriteInterface("StoreInterface")
+	mockc.WithConstructor()
+}
+
+func MockcKey() {
+	mockc.ExtractInterface(Key(""))
+}
+
+func MockcHTTPClient() {
+	mockc.ExtractInterface(&http.Client{})
+	mockc.WriteInterface("HTTPClient")
+}
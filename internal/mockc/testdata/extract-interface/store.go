@@ -0,0 +1,32 @@
+package basic
+
+import (
+	"context"
+)
+
+type Store struct {
+	m map[string]string
+}
+
+func (s *Store) Get(ctx context.Context, key string) (string, error) {
+	return s.m[key], nil
+}
+
+func (s *Store) Set(ctx context.Context, key string, val string) error {
+	s.m[key] = val
+	return nil
+}
+
+func (s *Store) reset() {
+	s.m = map[string]string{}
+}
+
+type Key string
+
+func (k Key) String() string {
+	return string(k)
+}
+
+func (k *Key) Set(v string) {
+	*k = Key(v)
+}
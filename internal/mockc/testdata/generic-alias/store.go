@@ -0,0 +1,14 @@
+package basic
+
+type Set[T comparable] = map[T]struct{}
+
+type Pairs[K comparable, V any] = []struct {
+	Key K
+	Val V
+}
+
+type TagStore interface {
+	Tags(id string) (Set[string], error)
+	SetTags(id string, tags Set[string]) error
+	Counts() Pairs[string, int]
+}
@@ -0,0 +1,11 @@
+//+build mockc
+
+package basic
+
+import (
+	"github.com/KimMachineGun/mockc"
+)
+
+func MockcUserRepo() {
+	mockc.Implement(Repo[User](nil))
+}
@@ -0,0 +1,18 @@
+package basic
+
+type User struct {
+	ID   string
+	Name string
+}
+
+type Pair[K comparable, V any] struct {
+	Key K
+	Val V
+}
+
+type Repo[T any] interface {
+	Get(id string) (T, error)
+	List() ([]T, error)
+	Save(v T) error
+	Pairs() []Pair[string, T]
+}
@@ -0,0 +1,16 @@
+//+build mockc
+
+package basic
+
+import (
+	"github.com/KimMachineGun/mockc"
+)
+
+func MockcStore[K comparable, V any]() {
+	mockc.Implement(Store[K, V](nil))
+	mockc.WithConstructor()
+}
+
+func MockcCounter[N ~int | ~int64]() {
+	mockc.Implement(Counter[N](nil))
+}
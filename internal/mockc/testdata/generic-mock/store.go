@@ -0,0 +1,15 @@
+package basic
+
+type Number interface {
+	~int | ~int64 | float64
+}
+
+type Store[K comparable, V any] interface {
+	Get(key K) (V, bool)
+	Set(key K, val V)
+	Keys() []K
+}
+
+type Counter[N Number] interface {
+	Add(delta N) N
+}
@@ -0,0 +1,14 @@
+package basic
+
+import (
+	"net/http"
+	"time"
+)
+
+type Clock func() time.Time
+
+type Mapper[T any] func(v T) (T, error)
+
+type Middleware func(next http.Handler) http.Handler
+
+type Join func(sep string, elems ...string) string
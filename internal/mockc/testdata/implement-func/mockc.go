@@ -0,0 +1,38 @@
+//+build mockc
+
+package basic
+
+import (
+	"net/http"
+
+	"github.com/KimMachineGun/mockc"
+)
+
+func MockcClock() {
+	mockc.ImplementFunc(Clock(nil))
+	mockc.WithConstructor()
+}
+
+func MockcMapper[T any]() {
+	mockc.ImplementFunc(Mapper[T](nil))
+}
+
+func MockcHandlerFunc() {
+	mockc.ImplementFunc(http.HandlerFunc(nil))
+	mockc.AsSpy()
+}
+
+func MockcMiddleware() {
+	mockc.ImplementFunc(Middleware(nil))
+	mockc.UseParamNames()
+}
+
+func MockcJoin() {
+	mockc.ImplementFunc(Join(nil))
+	mockc.UseParamNames()
+	mockc.WithExpectations()
+}
+
+func MockcFunc() {
+	mockc.ImplementFunc(func(int) error(nil))
+}
@@ -0,0 +1,24 @@
+//+build mockc
+
+package basic
+
+import (
+	"github.com/KimMachineGun/mockc"
+)
+
+func MockcReadWriteCloser() {
+	mockc.ImplementPath("io.ReadWriteCloser")
+}
+
+func MockcConn() {
+	mockc.ImplementPath("database/sql/driver.Conn")
+}
+
+func MockcTestingT() {
+	mockc.ImplementPath("github.com/stretchr/testify/assert.TestingT")
+}
+
+func MockcCacheCloser() {
+	mockc.Implement(Cache(nil))
+	mockc.ImplementPath("io.Closer")
+}
@@ -0,0 +1,5 @@
+package basic
+
+type Filter interface {
+	Match(key string, keyFunc string) bool
+}
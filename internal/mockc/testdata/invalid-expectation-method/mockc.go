@@ -0,0 +1,13 @@
+//+build mockc
+
+package basic
+
+import (
+	"github.com/KimMachineGun/mockc"
+)
+
+func MockcFilter() {
+	mockc.Implement(Filter(nil))
+	mockc.UseParamNames()
+	mockc.WithExpectations()
+}
@@ -0,0 +1,13 @@
+//+build mockc
+
+package basic
+
+import (
+	"io"
+
+	"github.com/KimMachineGun/mockc"
+)
+
+func MockcReader() {
+	mockc.ExtractInterface(io.Reader(nil))
+}
@@ -0,0 +1,3 @@
+package basic
+
+type Reset func(key string) error
@@ -0,0 +1,12 @@
+//+build mockc
+
+package basic
+
+import (
+	"github.com/KimMachineGun/mockc"
+)
+
+func MockcReset() {
+	mockc.ImplementFunc(Reset(nil))
+	mockc.WithReset()
+}
@@ -0,0 +1,14 @@
+//+build mockc
+
+package basic
+
+import (
+	"net/http"
+
+	"github.com/KimMachineGun/mockc"
+)
+
+func MockcClock() {
+	mockc.ImplementFunc(Clock(nil))
+	mockc.Implement(http.Handler(nil))
+}
@@ -0,0 +1,11 @@
+//+build mockc
+
+package b
+
+import (
+	"github.com/KimMachineGun/mockc"
+)
+
+func MockcCache() {
+	mockc.Implement(Cache(nil))
+}
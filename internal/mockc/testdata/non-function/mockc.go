@@ -0,0 +1,11 @@
+//+build mockc
+
+package basic
+
+import (
+	"github.com/KimMachineGun/mockc"
+)
+
+func MockcClock() {
+	mockc.ImplementFunc("Clock(nil)")
+}
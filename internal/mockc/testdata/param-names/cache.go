@@ -0,0 +1,13 @@
+package basic
+
+import (
+	"context"
+)
+
+type Cache interface {
+	Get(ctx context.Context, key string) (val interface{}, err error)
+	Set(ctx context.Context, key string, val interface{}) (err error)
+	Del(context context.Context, keys ...string) error
+	Swap(a, A string, _ int, recv bool) (string, string)
+	Len(int) (n int)
+}
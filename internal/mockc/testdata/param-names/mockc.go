@@ -0,0 +1,13 @@
+//+build mockc
+
+package basic
+
+import (
+	"github.com/KimMachineGun/mockc"
+)
+
+func MockcCache() {
+	mockc.Implement(Cache(nil))
+	mockc.UseParamNames()
+	mockc.WithConstructor()
+}
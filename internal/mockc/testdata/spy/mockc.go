@@ -0,0 +1,19 @@
+//+build mockc
+
+package basic
+
+import (
+	"github.com/KimMachineGun/mockc"
+)
+
+func MockcCache() {
+	mockc.Implement(Cache(nil))
+	mockc.AsSpy()
+}
+
+func MockcTestingCache() {
+	mockc.Implement(Cache(nil))
+	mockc.AsSpy()
+	mockc.WithTestingT()
+	mockc.SetConstructorName("NewSpy")
+}
@@ -0,0 +1,24 @@
+//+build mockc
+
+package basic
+
+import (
+	"github.com/KimMachineGun/mockc"
+)
+
+func MockcCache() {
+	mockc.Implement(Cache(nil))
+	mockc.Strict()
+}
+
+func MockcCacheWithTestingT() {
+	mockc.Implement(Cache(nil))
+	mockc.Strict()
+	mockc.WithTestingT()
+}
+
+func MockcCacheWithAccessors() {
+	mockc.Implement(Cache(nil))
+	mockc.Strict()
+	mockc.WithAccessors()
+}
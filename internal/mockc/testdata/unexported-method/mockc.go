@@ -0,0 +1,11 @@
+//+build mockc
+
+package basic
+
+import (
+	"github.com/KimMachineGun/mockc"
+)
+
+func MockcCode() {
+	mockc.ImplementPath("github.com/dave/jennifer/jen.Code")
+}
@@ -0,0 +1,11 @@
+package basic
+
+type Cache interface {
+	Get(key string) (val interface{}, err error)
+	Set(key string, val interface{}) (err error)
+	Del(key string) (err error)
+}
+
+type Store interface {
+	Load(key string) (val interface{}, err error)
+}
@@ -0,0 +1,22 @@
+//+build mockc
+
+package basic
+
+import (
+	"github.com/KimMachineGun/mockc"
+)
+
+func MockcCache() {
+	mockc.Implement(Cache(nil))
+	mockc.WithAccessors()
+}
+
+func MockcHiddenCache() {
+	mockc.Implement(Cache(nil))
+	mockc.HideFields()
+	mockc.WithTestingT()
+	mockc.WithExpectations()
+	mockc.WithCallOrder()
+	mockc.WithMatchers()
+	mockc.AsSpy()
+}
@@ -0,0 +1,20 @@
+//+build mockc
+
+package basic
+
+import (
+	"github.com/KimMachineGun/mockc"
+)
+
+func MockcCache() {
+	mockc.Implement(Cache(nil))
+	mockc.WithFailures()
+}
+
+func MockcStrictCache() {
+	mockc.Implement(Cache(nil))
+	mockc.WithFailures()
+	mockc.WithReset()
+	mockc.WithTestingT()
+	mockc.HideFields()
+}
@@ -0,0 +1,21 @@
+//+build mockc
+
+package basic
+
+import (
+	"github.com/KimMachineGun/mockc"
+)
+
+func MockcRepo() {
+	mockc.Implement(Repo(nil))
+	mockc.WithLatency()
+}
+
+func MockcHiddenRepo() {
+	mockc.Implement(Repo(nil))
+	mockc.WithLatency()
+	mockc.WithReset()
+	mockc.WithFailures()
+	mockc.WithTestingT()
+	mockc.HideFields()
+}
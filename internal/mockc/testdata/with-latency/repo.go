@@ -0,0 +1,11 @@
+package basic
+
+import (
+	"context"
+)
+
+type Repo interface {
+	Get(ctx context.Context, id string) (name string, err error)
+	Watch(ctx context.Context, id string) <-chan string
+	Count() int
+}
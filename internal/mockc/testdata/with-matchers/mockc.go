@@ -0,0 +1,19 @@
+//+build mockc
+
+package basic
+
+import (
+	"github.com/KimMachineGun/mockc"
+)
+
+func MockcCache() {
+	mockc.Implement(Cache(nil))
+	mockc.WithMatchers()
+}
+
+func MockcOrderedCache() {
+	mockc.Implement(Cache(nil))
+	mockc.WithMatchers()
+	mockc.WithCallOrder()
+	mockc.UseParamNames()
+}
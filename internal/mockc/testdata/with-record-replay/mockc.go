@@ -0,0 +1,20 @@
+//+build mockc
+
+package basic
+
+import (
+	"github.com/KimMachineGun/mockc"
+)
+
+func MockcRepo() {
+	mockc.Implement(Repo(nil))
+	mockc.WithRecordReplay()
+}
+
+func MockcStrictRepo() {
+	mockc.Implement(Repo(nil))
+	mockc.WithRecordReplay()
+	mockc.WithLatency()
+	mockc.WithTestingT()
+	mockc.SetConstructorName("NewStrictRepo")
+}
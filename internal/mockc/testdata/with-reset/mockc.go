@@ -0,0 +1,20 @@
+//+build mockc
+
+package basic
+
+import (
+	"github.com/KimMachineGun/mockc"
+)
+
+func MockcCache() {
+	mockc.Implement(Cache(nil))
+	mockc.WithReset()
+}
+
+func MockcExpectedCache() {
+	mockc.Implement(Cache(nil))
+	mockc.WithReset()
+	mockc.WithExpectations()
+	mockc.WithTestingT()
+	mockc.HideFields()
+}
@@ -0,0 +1,19 @@
+//+build mockc
+
+package basic
+
+import (
+	"github.com/KimMachineGun/mockc"
+)
+
+func MockcCache() {
+	mockc.Implement(Cache(nil))
+	mockc.WithTranscript()
+}
+
+func MockcHiddenCache() {
+	mockc.Implement(Cache(nil))
+	mockc.WithTranscript()
+	mockc.UseParamNames()
+	mockc.HideFields()
+}
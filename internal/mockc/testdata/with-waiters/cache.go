@@ -0,0 +1,7 @@
+package basic
+
+type Cache interface {
+	Get(key string) (val interface{}, err error)
+	Set(key string, val interface{}) (err error)
+	Flush()
+}
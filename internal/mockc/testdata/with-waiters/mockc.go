@@ -0,0 +1,18 @@
+//+build mockc
+
+package basic
+
+import (
+	"github.com/KimMachineGun/mockc"
+)
+
+func MockcCache() {
+	mockc.Implement(Cache(nil))
+	mockc.WithWaiters()
+}
+
+func MockcHiddenCache() {
+	mockc.Implement(Cache(nil))
+	mockc.WithWaiters()
+	mockc.HideFields()
+}
@@ -11,6 +11,7 @@ type interfaceFinder struct {
 	pkg     *packages.Package
 	targets []string
 	result  map[string]*types.Interface
+	generic map[string]bool
 }
 
 func newInterfaceFinder(pkg *packages.Package, targets []string) *interfaceFinder {
@@ -18,6 +19,7 @@ func newInterfaceFinder(pkg *packages.Package, targets []string) *interfaceFinde
 		pkg:     pkg,
 		targets: targets,
 		result:  map[string]*types.Interface{},
+		generic: map[string]bool{},
 	}
 }
 
@@ -35,6 +37,7 @@ func (f *interfaceFinder) Visit(node ast.Node) ast.Visitor {
 	for _, interfaceName := range f.targets {
 		if interfaceName == n.Name.Name {
 			f.result[interfaceName] = inter
+			f.generic[interfaceName] = n.TypeParams != nil
 		}
 	}
 
@@ -0,0 +1,29 @@
+package mockc
+
+import (
+	"context"
+	"time"
+)
+
+// Sleep waits for the duration d, or until the context is done if respectContext is true.
+// It returns the error of the context if the context is done while it's respected.
+func Sleep(ctx context.Context, d time.Duration, respectContext bool) error {
+	if !respectContext {
+		time.Sleep(d)
+		return nil
+	} else if err := ctx.Err(); err != nil {
+		return err
+	} else if d <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,199 @@
+// Package match provides the argument matchers for finding the calls recorded by the mocks.
+//
+// The matchers are used by the Find{METHOD_NAME}Calls methods of the mocks generated with mockc.WithMatchers.
+package match
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+// Matcher matches an argument of the method call.
+type Matcher interface {
+	Match(v interface{}) bool
+	String() string
+}
+
+type matcher struct {
+	match       func(v interface{}) bool
+	description string
+}
+
+func (m matcher) Match(v interface{}) bool {
+	return m.match(v)
+}
+
+func (m matcher) String() string {
+	return m.description
+}
+
+// Args reports whether the arguments are matched by the matchers in order.
+// The arguments without the corresponding matchers are always matched,
+// and the matchers without the corresponding arguments never match.
+func Args(matchers []Matcher, args ...interface{}) bool {
+	if len(matchers) > len(args) {
+		return false
+	}
+
+	for i, m := range matchers {
+		if m != nil && !m.Match(args[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Any matches any argument.
+func Any() Matcher {
+	return matcher{
+		match: func(interface{}) bool {
+			return true
+		},
+		description: "Any()",
+	}
+}
+
+// Eq matches the argument equal to the expected value with the == operator.
+// The arguments of the incomparable types never match.
+func Eq(expected interface{}) Matcher {
+	return matcher{
+		match: func(v interface{}) bool {
+			if v == nil || expected == nil {
+				return v == expected
+			} else if !reflect.TypeOf(v).Comparable() || !reflect.TypeOf(expected).Comparable() {
+				return false
+			}
+
+			return v == expected
+		},
+		description: fmt.Sprintf("Eq(%#v)", expected),
+	}
+}
+
+// DeepEq matches the argument deeply equal to the expected value.
+func DeepEq(expected interface{}) Matcher {
+	return matcher{
+		match: func(v interface{}) bool {
+			return reflect.DeepEqual(v, expected)
+		},
+		description: fmt.Sprintf("DeepEq(%#v)", expected),
+	}
+}
+
+// Regex matches the string, the []byte, or the fmt.Stringer argument that matches the regular expression.
+// It panics if the expression cannot be parsed.
+func Regex(expr string) Matcher {
+	re := regexp.MustCompile(expr)
+
+	return matcher{
+		match: func(v interface{}) bool {
+			switch v := v.(type) {
+			case string:
+				return re.MatchString(v)
+			case []byte:
+				return re.Match(v)
+			case fmt.Stringer:
+				return re.MatchString(v.String())
+			}
+
+			return false
+		},
+		description: fmt.Sprintf("Regex(%q)", expr),
+	}
+}
+
+// Len matches the string, array, slice, map, or channel argument whose length is n.
+func Len(n int) Matcher {
+	return matcher{
+		match: func(v interface{}) bool {
+			rv := reflect.ValueOf(v)
+			switch rv.Kind() {
+			case reflect.String, reflect.Array, reflect.Slice, reflect.Map, reflect.Chan:
+				return rv.Len() == n
+			}
+
+			return false
+		},
+		description: fmt.Sprintf("Len(%d)", n),
+	}
+}
+
+// Contains matches the string argument that contains the substring,
+// the array or slice argument that has the element deeply equal to the value,
+// or the map argument that has the key equal to the value.
+func Contains(value interface{}) Matcher {
+	return matcher{
+		match: func(v interface{}) bool {
+			if s, ok := v.(string); ok {
+				substr, ok := value.(string)
+				return ok && strings.Contains(s, substr)
+			}
+
+			rv := reflect.ValueOf(v)
+			switch rv.Kind() {
+			case reflect.Array, reflect.Slice:
+				for i := 0; i < rv.Len(); i++ {
+					if reflect.DeepEqual(rv.Index(i).Interface(), value) {
+						return true
+					}
+				}
+			case reflect.Map:
+				key := reflect.ValueOf(value)
+				if !key.IsValid() || !key.Type().Comparable() || !key.Type().AssignableTo(rv.Type().Key()) {
+					return false
+				}
+
+				return rv.MapIndex(key).IsValid()
+			}
+
+			return false
+		},
+		description: fmt.Sprintf("Contains(%#v)", value),
+	}
+}
+
+// Func matches the argument of the type T for which the function returns true.
+// The arguments of the other types never match.
+func Func[T any](f func(v T) bool) Matcher {
+	return matcher{
+		match: func(v interface{}) bool {
+			t, ok := v.(T)
+			return ok && f(t)
+		},
+		description: fmt.Sprintf("Func(%T)", f),
+	}
+}
+
+// Not matches the argument that is not matched by the matcher.
+func Not(m Matcher) Matcher {
+	return matcher{
+		match: func(v interface{}) bool {
+			return !m.Match(v)
+		},
+		description: fmt.Sprintf("Not(%v)", m),
+	}
+}
+
+// AllOf matches the argument that is matched by all the matchers.
+func AllOf(matchers ...Matcher) Matcher {
+	descriptions := make([]string, len(matchers))
+	for i, m := range matchers {
+		descriptions[i] = m.String()
+	}
+
+	return matcher{
+		match: func(v interface{}) bool {
+			for _, m := range matchers {
+				if !m.Match(v) {
+					return false
+				}
+			}
+
+			return true
+		},
+		description: fmt.Sprintf("AllOf(%s)", strings.Join(descriptions, ", ")),
+	}
+}
@@ -0,0 +1,108 @@
+package match
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type stringer string
+
+func (s stringer) String() string {
+	return string(s)
+}
+
+func TestMatchers(t *testing.T) {
+	testCases := []struct {
+		name    string
+		matcher Matcher
+		matched []interface{}
+		missed  []interface{}
+	}{
+		{
+			name:    "Any",
+			matcher: Any(),
+			matched: []interface{}{nil, 1, "a", []int{1}},
+		},
+		{
+			name:    "Eq",
+			matcher: Eq("a"),
+			matched: []interface{}{"a"},
+			missed:  []interface{}{nil, "b", stringer("a"), []string{"a"}},
+		},
+		{
+			name:    "DeepEq",
+			matcher: DeepEq([]int{1, 2}),
+			matched: []interface{}{[]int{1, 2}},
+			missed:  []interface{}{nil, []int{1}, []int64{1, 2}},
+		},
+		{
+			name:    "Regex",
+			matcher: Regex("^user:"),
+			matched: []interface{}{"user:1", []byte("user:2"), stringer("user:3")},
+			missed:  []interface{}{nil, "admin:1", 1},
+		},
+		{
+			name:    "Len",
+			matcher: Len(2),
+			matched: []interface{}{"ab", []int{1, 2}, [2]int{}, map[string]int{"a": 1, "b": 2}},
+			missed:  []interface{}{nil, "a", 2},
+		},
+		{
+			name:    "Contains",
+			matcher: Contains("b"),
+			matched: []interface{}{"abc", []string{"a", "b"}, map[string]int{"b": 1}},
+			missed:  []interface{}{nil, "ac", []string{"a"}, map[string]int{"a": 1}, map[int]int{1: 1}},
+		},
+		{
+			name: "Func",
+			matcher: Func(func(v string) bool {
+				return strings.HasSuffix(v, "!")
+			}),
+			matched: []interface{}{"hi!"},
+			missed:  []interface{}{nil, "hi", 1},
+		},
+		{
+			name:    "Not",
+			matcher: Not(Eq(1)),
+			matched: []interface{}{nil, 2, "1"},
+			missed:  []interface{}{1},
+		},
+		{
+			name:    "AllOf",
+			matcher: AllOf(Regex("^user:"), Len(6)),
+			matched: []interface{}{"user:1"},
+			missed:  []interface{}{"user:10", "admin1"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			a := assert.New(t)
+
+			for _, v := range tc.matched {
+				a.True(tc.matcher.Match(v), "%v should match %#v", tc.matcher, v)
+			}
+			for _, v := range tc.missed {
+				a.False(tc.matcher.Match(v), "%v should not match %#v", tc.matcher, v)
+			}
+		})
+	}
+}
+
+func TestArgs(t *testing.T) {
+	a := assert.New(t)
+
+	a.True(Args(nil, "a", 1))
+	a.True(Args([]Matcher{Eq("a")}, "a", 1))
+	a.True(Args([]Matcher{nil, Eq(1)}, "a", 1))
+	a.False(Args([]Matcher{Eq("b")}, "a", 1))
+	a.False(Args([]Matcher{Any(), Any(), Any()}, "a", 1))
+}
+
+func TestString(t *testing.T) {
+	a := assert.New(t)
+
+	a.Equal(`AllOf(Regex("^user:"), Not(Eq(1)), Len(2))`, AllOf(Regex("^user:"), Not(Eq(1)), Len(2)).String())
+}
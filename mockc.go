@@ -1,8 +1,34 @@
+// Package mockc declares the options of the mock generators.
+// It also provides the runtime helpers used by the generated mocks, like NextSeq, Sleep, FormatCall and NewFixture,
+// which the tests don't need to call.
 package mockc
 
 // Implement designates the interfaces to be implemented.
+// If the mock generator has type parameters, the mock will be generated as a generic type with the same type parameters.
 func Implement(i ...interface{}) {}
 
+// ImplementPath designates the interfaces to be implemented by their paths like "io.ReadWriteCloser" or "database/sql/driver.Conn".
+// Unlike Implement, the packages of the interfaces don't have to be imported by the mock generator,
+// so you can implement the interfaces of the standard library and the third-party modules required by your module.
+// The paths should be constant strings in the {package_path}.{interface_name} format, and generic interfaces are not supported.
+func ImplementPath(paths ...string) {}
+
+// ImplementFunc designates the function type to be implemented instead of the interfaces.
+// The mock will have a method named after the function type (or "Call" if it's not a named type),
+// and Func method that returns the method value as the function type.
+// ImplementFunc can't be used with Implement or ImplementPath in the same mock generator.
+func ImplementFunc(f interface{}) {}
+
+// ExtractInterface designates the concrete types whose exported method sets are implemented.
+// The method set of the pointer type like &http.Client{} includes the methods with the pointer receivers,
+// and the method set of the value type like http.Header{} only includes the methods with the value receivers.
+func ExtractInterface(v ...interface{}) {}
+
+// WriteInterface writes the interface implemented by the mock into the mockc_interface_gen.go with the given name.
+// Unlike the mocks, the interface is not excluded by the mockc build tag, so it can be referred by your package.
+// The undefined references to the interface are tolerated while the package is loaded, so it can be generated from scratch.
+func WriteInterface(name string) {}
+
 // SetFieldNamePrefix sets the prefix of the mock's field names.
 func SetFieldNamePrefix(prefix string) {}
 
@@ -14,6 +40,19 @@ func SetFieldNameSuffix(suffix string) {}
 // If the destination is not a go file, the mock generation will fail.
 func SetDestination(destination string) {}
 
+// UseParamNames names the fields of the params and results after the names declared in the interface.
+// The names are capitalized, and the index based names like P0 and R0 are used for the blank or conflicted names.
+// The declared names are also used as the parameter names of the mock's methods.
+func UseParamNames() {}
+
+// WithExpectations generates the expectation api of the mock.
+// You can declare the expected calls with the Expect{METHOD_NAME} methods,
+// and verify them with the AssertExpectations method.
+// If an expectation matches the call, its results are used as the results of the method.
+// The function params can be matched only by the With{PARAM}Func methods, since functions cannot be compared.
+// Without the Times method, an expectation should be matched at least once, and Times(0) declares the calls that should never be made.
+func WithExpectations() {}
+
 // WithConstructor generates the constructor of mock.
 // You can set the underlying implementation by passing real implementation to the constructor.
 //
@@ -23,6 +62,81 @@ func SetDestination(destination string) {}
 // https://github.com/KimMachineGun/mockc/tree/master/examples/with-constructor
 func WithConstructor() {}
 
+// WithTestingT makes the constructor of mock take testing.TB as its first parameter.
+// The mock will be verified when the test finishes, and the test will fail if a method whose Required field is true
+// has never been called, or if a method has been called without configured behavior.
+// A call is regarded as unconfigured if the method has results, its Body is nil, and nothing else has configured its results.
+// The results are regarded as configured if they are non-zero, if they are taken from the ResultsSeq,
+// or if they are set by the generated Set{METHOD_NAME}Results method, so zero results should be configured with the latter.
+//
+// If the constructor name is not set, WithTestingT is equivalent to the SetConstructorName("New" + MOCK_NAME).
+func WithTestingT() {}
+
+// Strict makes the mock's methods fail on the unconfigured calls.
+// If the mock has testing.TB given by the constructor generated with WithTestingT, the failure is reported through it.
+// Otherwise, the method panics with the mock name, the method name, and the arguments.
+func Strict() {}
+
+// WithCallOrder records the global sequence number of every method call in the History.
+// The mock will have Calls method, and you can assert the order of the calls across the mocks with InOrder.
+func WithCallOrder() {}
+
+// WithMatchers generates the Find{METHOD_NAME}Calls methods of the mock,
+// which return the recorded calls whose params are matched by the matchers of the github.com/KimMachineGun/mockc/match package in order.
+func WithMatchers() {}
+
+// WithAccessors generates the methods that access the state of the mock's methods with their locks held,
+// so that the state can be accessed while the mock is called from other goroutines.
+// The accessors are {METHOD_NAME}CallCount, {METHOD_NAME}Params, {METHOD_NAME}History (returns a copy),
+// Set{METHOD_NAME}Results, Append{METHOD_NAME}Results, Set{METHOD_NAME}Body, and Require{METHOD_NAME} (only with WithTestingT).
+func WithAccessors() {}
+
+// HideFields makes the fields of the mock's methods' state unexported, so that the code outside the mock's package
+// uses the accessors instead of accessing the state without the lock. The tests in the same package can still access the fields.
+// HideFields implies WithAccessors.
+func HideFields() {}
+
+// WithWaiters generates the methods that block until the mock's methods are called.
+// Wait{METHOD_NAME}(ctx, n) blocks until the method has been called n times or the context is done,
+// and {METHOD_NAME}Called() returns the channel closed when the method has been called at least once.
+func WithWaiters() {}
+
+// WithReset generates Reset method of the mock and Reset{METHOD_NAME} methods,
+// which clear the recorded calls so that the mock can be reused across the subtests.
+// The configured Body and results are also cleared unless KeepBody or KeepResults is passed to the methods.
+func WithReset() {}
+
+// WithFailures generates the methods that inject the panics and the errors into the mock's methods.
+// {METHOD_NAME}PanicsWith(v) makes the method panic with v, {METHOD_NAME}FailsAfter(n, err) makes the method
+// return err as its last error result after n more calls, and FailAll(err) makes all the methods returning error fail with err.
+// The injected errors override the results of the Body, and they are cleared by passing nil as err.
+func WithFailures() {}
+
+// WithLatency generates the Delay and RespectContext fields of the mock's methods whose first param is context.Context.
+// The method sleeps for the Delay, and if the RespectContext is true, it stops sleeping when the context is done
+// and returns the error of the context as its last error result without calling the Body.
+func WithLatency() {}
+
+// WithTranscript generates the Transcript method of the mock, which returns all the recorded calls of the mock in call order.
+// You can compare the transcript with the golden file using AssertGolden.
+// WithTranscript implies WithCallOrder.
+func WithTranscript() {}
+
+// AsSpy generates the mock as a spy that wraps a real implementation.
+// The constructor of the spy takes the delegate, and the spy forwards the calls to it unless the Body of the method is set,
+// an entry of the ResultsSeq is left, or a matched expectation returns the results.
+// The mock will have Delegate method, and Restore{METHOD_NAME} methods that clear the Body to restore the forwarding.
+//
+// If the constructor name is not set, AsSpy is equivalent to the SetConstructorName("New" + MOCK_NAME).
+func AsSpy() {}
+
+// WithRecordReplay generates the mock as a spy that records the calls forwarded to the delegate into a fixture file,
+// or replays the results of the calls recorded in the fixture file without the delegate.
+// The mock will have Record, Replay and UseFixture methods, which should be called before the mock is used.
+// UseFixture records the calls if the MOCKC_RECORD environment variable is set to a true value, and replays them otherwise.
+// WithRecordReplay implies AsSpy.
+func WithRecordReplay() {}
+
 // SetConstructorName sets the constructor name.
 // If the name is empty string, the constructor won't be generated.
 func SetConstructorName(name string) {}
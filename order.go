@@ -0,0 +1,60 @@
+package mockc
+
+import (
+	"fmt"
+	"sync/atomic"
+)
+
+var seq uint64
+
+// NextSeq returns the next global sequence number, which orders the calls across the mocks.
+func NextSeq() uint64 {
+	return atomic.AddUint64(&seq, 1)
+}
+
+// TB is the subset of testing.TB that is used by this package.
+// It keeps the package from importing testing, so that the package can be imported by the non-test code.
+type TB interface {
+	Helper()
+	Errorf(format string, args ...interface{})
+	Fatalf(format string, args ...interface{})
+	Cleanup(f func())
+}
+
+// Call is a method call of the mock generated with WithCallOrder.
+// If the method has not been called for the index, Seq is zero.
+type Call struct {
+	Mock   string
+	Method string
+	Index  int
+	Seq    uint64
+}
+
+func (c Call) String() string {
+	return fmt.Sprintf("%s.%s[%d]", c.Mock, c.Method, c.Index)
+}
+
+// InOrder asserts that the given calls have been made in the given order.
+// It reports the failures through the given TB, and returns whether the calls are in order.
+//
+//	mockc.InOrder(t, m1.Calls().Set(0), m2.Calls().Get(0))
+func InOrder(t TB, calls ...Call) bool {
+	t.Helper()
+
+	for _, call := range calls {
+		if call.Seq == 0 {
+			t.Errorf("mockc: %s has not been called", call)
+			return false
+		}
+	}
+
+	ok := true
+	for i := 1; i < len(calls); i++ {
+		if calls[i-1].Seq >= calls[i].Seq {
+			t.Errorf("mockc: %s should be called before %s", calls[i-1], calls[i])
+			ok = false
+		}
+	}
+
+	return ok
+}
@@ -0,0 +1,23 @@
+package mockc
+
+// ResetOption designates the configured state kept by the Reset methods of the mocks generated with WithReset.
+// Without any options, the Reset methods clear both the recorded calls and the configured state.
+type ResetOption int
+
+const (
+	// KeepBody keeps the Body of the methods, and the Delay and RespectContext of the methods generated with WithLatency.
+	KeepBody ResetOption = 1 << iota
+	// KeepResults keeps the Results and the ResultsSeq of the methods, and the expectations declared by the Expect methods.
+	KeepResults
+)
+
+// Keeps reports whether the options passed to the Reset methods keep the configured state designated by o.
+func (o ResetOption) Keeps(opts []ResetOption) bool {
+	for _, opt := range opts {
+		if opt&o != 0 {
+			return true
+		}
+	}
+
+	return false
+}
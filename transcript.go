@@ -0,0 +1,108 @@
+package mockc
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// TranscriptCall is a method call recorded in the Transcript.
+type TranscriptCall struct {
+	Seq     uint64
+	Method  string
+	Params  []interface{}
+	Results []interface{}
+}
+
+// Transcript is the method calls of the mock generated with WithTranscript in call order.
+// Its String method serializes the calls into the stable text, which can be compared with AssertGolden.
+type Transcript struct {
+	Mock  string
+	Calls []TranscriptCall
+}
+
+// NewTranscript returns the transcript of the calls sorted by their sequence numbers.
+func NewTranscript(mock string, calls []TranscriptCall) Transcript {
+	sort.SliceStable(calls, func(i, j int) bool {
+		return calls[i].Seq < calls[j].Seq
+	})
+
+	return Transcript{
+		Mock:  mock,
+		Calls: calls,
+	}
+}
+
+// String returns a line for each call in the form of `{INDEX}: {MOCK}.{METHOD}({PARAMS}) -> {RESULTS}`.
+// The values are formatted as JSON, except for nil, errors, contexts, functions and channels,
+// so that the text doesn't depend on the addresses and the global sequence numbers.
+func (t Transcript) String() string {
+	var sb strings.Builder
+	for i, call := range t.Calls {
+		fmt.Fprintf(&sb, "%d: %s.%s(%s)", i+1, t.Mock, call.Method, formatValues(call.Params))
+		if len(call.Results) > 0 {
+			fmt.Fprintf(&sb, " -> %s", formatValues(call.Results))
+		}
+		sb.WriteByte('\n')
+	}
+
+	return sb.String()
+}
+
+// Transcriber is implemented by the mocks generated with WithTranscript.
+type Transcriber interface {
+	Transcript() Transcript
+}
+
+// UpdateEnv is the environment variable that makes AssertGolden update the golden files when it is set to a true value like 1.
+const UpdateEnv = "MOCKC_UPDATE"
+
+// Updating reports whether the UpdateEnv environment variable is set to a true value.
+func Updating() bool {
+	v, _ := strconv.ParseBool(os.Getenv(UpdateEnv))
+	return v
+}
+
+// AssertGolden asserts that the transcript of the mock is the same as the content of the golden file.
+// If the MOCKC_UPDATE environment variable is set to a true value, it writes the transcript to the golden file instead.
+// It reports the failures through the given TB, and returns whether the transcript matches.
+//
+//	mockc.AssertGolden(t, "testdata/checkout.golden", m)
+func AssertGolden(t TB, path string, m Transcriber) bool {
+	t.Helper()
+
+	transcript := []byte(m.Transcript().String())
+
+	if Updating() {
+		err := os.MkdirAll(filepath.Dir(path), 0777)
+		if err == nil {
+			err = ioutil.WriteFile(path, transcript, 0666)
+		}
+		if err != nil {
+			t.Errorf("mockc: cannot update the golden file %s: %v", path, err)
+			return false
+		}
+		return true
+	}
+
+	golden, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		t.Errorf("mockc: the golden file %s does not exist: run the test with MOCKC_UPDATE=1 to create it", path)
+		return false
+	} else if err != nil {
+		t.Errorf("mockc: cannot read the golden file %s: %v", path, err)
+		return false
+	}
+
+	if !bytes.Equal(golden, transcript) {
+		t.Errorf("mockc: the transcript does not match the golden file %s:\n--- golden\n%s--- transcript\n%s", path, golden, transcript)
+		return false
+	}
+
+	return true
+}